@@ -1,11 +1,10 @@
 package main
 
 import (
-	"bytes"
-	"io"
-	"io/ioutil"
+	"fmt"
 	"math/rand"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -13,8 +12,12 @@ import (
 
 	"github.com/DataDog/datadog-process-agent/checks"
 	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/exporter/otlp"
+	"github.com/DataDog/datadog-process-agent/exporter/prometheus"
+	"github.com/DataDog/datadog-process-agent/forwarder"
 	"github.com/DataDog/datadog-process-agent/model"
 	"github.com/DataDog/datadog-process-agent/statsd"
+	"github.com/DataDog/datadog-process-agent/util"
 )
 
 type checkPayload struct {
@@ -24,15 +27,49 @@ type checkPayload struct {
 
 // Collector will collect metrics from the local system and ship to the backend.
 type Collector struct {
-	send          chan checkPayload
-	rtIntervalCh  chan time.Duration
-	cfg           *config.AgentConfig
-	httpClient    http.Client
-	groupID       int32
-	runCounter    int64
-	enabledChecks []checks.Check
-
-	// Controls the real-time interval, can change live.
+	send       chan checkPayload
+	groupID    int32
+	runCounter int64
+
+	// The current *config.AgentConfig, swapped when the configuration is reloaded
+	// or when the intake pushes new settings.
+	cfg *atomic.Value
+	// Serializes the configuration changes, and guards the fields below.
+	reloadMu *sync.Mutex
+	// The configuration read from the files and the environment.
+	localCfg *config.AgentConfig
+	// The settings pushed by the intake, applied on top of localCfg, and the
+	// latest ones received.
+	remote     remoteSettings
+	lastRemote *model.RemoteSettings
+
+	// Strips the unchanged process metadata from the submitted payloads.
+	delta *deltaEncoder
+
+	// The latest *model.SystemInfo, refreshed periodically by the collector.
+	// Each check is re-initialized with it when its Sequence changes.
+	sysInfo *atomic.Value
+
+	// Exposes the latest check results to Prometheus, nil when disabled.
+	promExporter *prometheus.Exporter
+	// Pushes the check results to an OTLP collector, nil when disabled, from its
+	// own routine fed by otlpSend so that a slow collector doesn't hold up the
+	// submissions.
+	otlpExporter *otlp.Exporter
+	otlpSend     chan []model.MessageBody
+	// The destinations of the payloads by sink name, see config.EndpointSinks.
+	forwarders   map[string]forwarder.Forwarder
+	forwardersMu *sync.RWMutex
+
+	// The checks which can be enabled, and the routines running the enabled
+	// ones by check name.
+	checks    []checks.Check
+	runners   map[string]*checkRunner
+	runnersMu *sync.Mutex
+	// The exit channel given to run, nil until the collector runs.
+	exit chan bool
+
+	// Controls the real-time interval, can change live. Guarded by runnersMu.
 	realTimeInterval time.Duration
 	// Set to 1 if enabled 0 is not. We're using an integer
 	// so we can use the sync/atomic for thread-safe access.
@@ -45,22 +82,44 @@ func NewCollector(cfg *config.AgentConfig) (Collector, error) {
 	if err != nil {
 		return Collector{}, err
 	}
+	sysInfo.Sequence = 1
+	sysInfoValue := &atomic.Value{}
+	sysInfoValue.Store(sysInfo)
 
-	enabledChecks := make([]checks.Check, 0)
-	for _, c := range checks.All {
-		if cfg.CheckIsEnabled(c.Name()) {
-			c.Init(cfg, sysInfo)
-			enabledChecks = append(enabledChecks, c)
-		}
+	var promExporter *prometheus.Exporter
+	if cfg.PrometheusListenAddr != "" {
+		promExporter = prometheus.NewExporter(cfg)
+	}
+	var otlpExporter *otlp.Exporter
+	var otlpSend chan []model.MessageBody
+	if cfg.OTLPEndpoint != "" {
+		otlpExporter = otlp.NewExporter(cfg, Version)
+		otlpSend = make(chan []model.MessageBody, cfg.QueueSize)
+	}
+	forwarders, err := newForwarders(cfg)
+	if err != nil {
+		return Collector{}, err
 	}
 
+	cfgValue := &atomic.Value{}
+	cfgValue.Store(cfg)
+
 	return Collector{
-		send:          make(chan checkPayload, cfg.QueueSize),
-		rtIntervalCh:  make(chan time.Duration),
-		cfg:           cfg,
-		groupID:       rand.Int31(),
-		httpClient:    http.Client{Transport: cfg.Transport},
-		enabledChecks: enabledChecks,
+		send:         make(chan checkPayload, cfg.QueueSize),
+		cfg:          cfgValue,
+		reloadMu:     &sync.Mutex{},
+		localCfg:     cfg,
+		delta:        newDeltaEncoder(),
+		groupID:      rand.Int31(),
+		sysInfo:      sysInfoValue,
+		promExporter: promExporter,
+		otlpExporter: otlpExporter,
+		otlpSend:     otlpSend,
+		forwarders:   forwarders,
+		forwardersMu: &sync.RWMutex{},
+		checks:       checks.All,
+		runners:      make(map[string]*checkRunner),
+		runnersMu:    &sync.Mutex{},
 
 		// Defaults for real-time on start
 		realTimeInterval: 2 * time.Second,
@@ -68,16 +127,24 @@ func NewCollector(cfg *config.AgentConfig) (Collector, error) {
 	}, nil
 }
 
+// agentConfig returns the current configuration.
+func (l *Collector) agentConfig() *config.AgentConfig {
+	return l.cfg.Load().(*config.AgentConfig)
+}
+
 func (l *Collector) runCheck(c checks.Check) {
 	runCounter := atomic.AddInt64(&l.runCounter, 1)
 	s := time.Now()
 	// update the last collected timestamp for info
 	updateLastCollectTime(time.Now())
-	messages, err := c.Run(l.cfg, atomic.AddInt32(&l.groupID, 1))
+	messages, err := c.Run(l.agentConfig(), atomic.AddInt32(&l.groupID, 1))
 	if err != nil {
 		log.Criticalf("Unable to run check '%s': %s", c.Name(), err)
 	} else {
 		l.send <- checkPayload{messages, c.Endpoint()}
+		if l.promExporter != nil {
+			l.promExporter.Update(messages)
+		}
 		// update proc and container count for info
 		updateProcContainerCount(messages)
 		if !c.RealTime() {
@@ -95,135 +162,405 @@ func (l *Collector) runCheck(c checks.Check) {
 }
 
 func (l *Collector) run(exit chan bool) {
-	log.Infof("Starting process-agent for host=%s, endpoint=%s, enabled checks=%v", l.cfg.HostName, l.cfg.APIEndpoint, l.cfg.EnabledChecks)
-	go handleSignals(exit)
+	cfg := l.agentConfig()
+	log.Infof("Starting process-agent for host=%s, endpoint=%s, enabled checks=%v", cfg.HostName, cfg.APIEndpoint, cfg.EnabledChecks)
+	go handleSignals(exit, func() {
+		if err := l.reloadConfig(); err != nil {
+			log.Errorf("Unable to reload the configuration: %s", err)
+		}
+	})
 	heartbeat := time.NewTicker(15 * time.Second)
 	queueSizeTicker := time.NewTicker(10 * time.Second)
 	go func() {
 		for {
 			select {
 			case payload := <-l.send:
-				if len(l.send) >= l.cfg.QueueSize {
+				if len(l.send) >= cap(l.send) {
 					log.Info("Expiring payload from in-memory queue.")
 					// Limit number of items kept in memory while we wait.
 					<-l.send
 				}
-				for _, m := range payload.messages {
-					l.postMessage(payload.endpoint, m)
-				}
+				l.queueOTLPExport(payload.messages)
+				l.submitMessages(payload.endpoint, payload.messages)
 			case <-heartbeat.C:
 				statsd.Client.Gauge("datadog.process.agent", 1, []string{"version:" + Version}, 1)
 			case <-queueSizeTicker.C:
 				updateQueueSize(l.send)
 			case <-exit:
+				l.forwardersMu.RLock()
+				closeForwarders(l.forwarders)
+				l.forwardersMu.RUnlock()
 				return
 			}
 		}
 	}()
 
-	for _, c := range l.enabledChecks {
-		go func(c checks.Check) {
-			// Run the check the first time to prime the caches.
-			if !c.RealTime() {
-				l.runCheck(c)
-			}
+	go l.refreshSystemInfo(exit)
+	if l.otlpExporter != nil {
+		go l.exportOTLP(exit)
+	}
 
-			ticker := time.NewTicker(l.cfg.CheckInterval(c.Name()))
-			for {
-				select {
-				case <-ticker.C:
-					realTimeEnabled := atomic.LoadInt64(&l.realTimeEnabled) == 1
-					if !c.RealTime() || realTimeEnabled {
-						l.runCheck(c)
-					}
-				case d := <-l.rtIntervalCh:
-					// Live-update the ticker.
-					if c.RealTime() {
-						ticker.Stop()
-						ticker = time.NewTicker(d)
-					}
-				case _, ok := <-exit:
-					if !ok {
-						return
-					}
-				}
+	if l.promExporter != nil {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", l.promExporter)
+			log.Infof("Exposing Prometheus metrics on http://%s/metrics", cfg.PrometheusListenAddr)
+			if err := http.ListenAndServe(cfg.PrometheusListenAddr, mux); err != nil {
+				log.Errorf("Error running the Prometheus endpoint: %s", err)
 			}
-		}(c)
+		}()
 	}
+
+	l.runnersMu.Lock()
+	l.exit = exit
+	for _, c := range l.checks {
+		if cfg.CheckIsEnabled(c.Name()) {
+			l.startCheck(c, cfg.CheckInterval(c.Name()), nil)
+		}
+	}
+	l.runnersMu.Unlock()
 	<-exit
 }
 
-func (l *Collector) postMessage(endpoint string, m model.MessageBody) {
-	msgType, err := model.DetectMessageType(m)
-	if err != nil {
-		log.Errorf("Unable to detect message type: %s", err)
-		return
+// checkRunner is the routine running an enabled check.
+type checkRunner struct {
+	// The configured interval of the check.
+	interval time.Duration
+	// Receives the new intervals of the check, buffered so that a new interval
+	// replaces a pending one instead of blocking.
+	intervalCh chan time.Duration
+	// Closed to stop the routine, which then closes done.
+	stop chan struct{}
+	done chan struct{}
+}
+
+func (r *checkRunner) setInterval(d time.Duration) {
+	select {
+	case <-r.intervalCh:
+	default:
+	}
+	r.intervalCh <- d
+}
+
+// startCheck starts the routine running a check, once the routine previously
+// running it, if any, is done. The caller must hold runnersMu.
+func (l *Collector) startCheck(c checks.Check, interval time.Duration, previous *checkRunner) {
+	r := &checkRunner{
+		interval:   interval,
+		intervalCh: make(chan time.Duration, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
 	}
+	l.runners[c.Name()] = r
+	exit := l.exit
 
-	body, err := model.EncodeMessage(model.Message{
-		Header: model.MessageHeader{
-			Version:  model.MessageV3,
-			Encoding: model.MessageEncodingZstdPB,
-			Type:     msgType,
-		}, Body: m})
+	go func() {
+		defer close(r.done)
+		if previous != nil {
+			<-previous.done
+		}
+		info := l.sysInfo.Load().(*model.SystemInfo)
+		sequence := info.Sequence
+		c.Init(l.agentConfig(), info)
+
+		// Run the check the first time to prime the caches.
+		if !c.RealTime() {
+			l.runCheck(c)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer func() { ticker.Stop() }()
+		for {
+			select {
+			case <-ticker.C:
+				// Re-initialize the check from its own routine if the system info changed.
+				if info := l.sysInfo.Load().(*model.SystemInfo); info.Sequence != sequence {
+					c.Init(l.agentConfig(), info)
+					sequence = info.Sequence
+				}
+				realTimeEnabled := atomic.LoadInt64(&l.realTimeEnabled) == 1
+				if !c.RealTime() || realTimeEnabled {
+					l.runCheck(c)
+				}
+			case d := <-r.intervalCh:
+				// Live-update the ticker.
+				ticker.Stop()
+				ticker = time.NewTicker(d)
+			case <-r.stop:
+				return
+			case _, ok := <-exit:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// reloadConfig reads the configuration again and applies it, see reload.
+func (l *Collector) reloadConfig() error {
+	agentConf, yamlConf, err := readConfigFiles()
 	if err != nil {
-		log.Errorf("Unable to encode message: %s", err)
+		return err
 	}
-	l.cfg.APIEndpoint.Path = endpoint
-	url := l.cfg.APIEndpoint.String()
-	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	cfg, err := config.NewAgentConfig(agentConf, yamlConf)
 	if err != nil {
-		log.Errorf("could not create request: %s", err)
-		return
+		return err
 	}
-	req.Header.Add("X-Dd-APIKey", l.cfg.APIKey)
-	req.Header.Add("X-Dd-Hostname", l.cfg.HostName)
-	req.Header.Add("X-Dd-Processagentversion", Version)
+	return l.reload(cfg)
+}
+
+// reload swaps the configuration of the running collector. The forwarders are
+// created again for the new endpoints and the checks are updated, see
+// applyChecks. The settings pushed by the intake stay applied on top of it.
+// The collector is left untouched when the new configuration is invalid.
+// Changes to the queue size and to the Prometheus and OTLP exporters are only
+// applied on restart.
+func (l *Collector) reload(cfg *config.AgentConfig) error {
+	l.reloadMu.Lock()
+	defer l.reloadMu.Unlock()
 
-	resp, err := l.httpClient.Do(req)
+	forwarders, err := newForwarders(cfg)
 	if err != nil {
-		if isHTTPTimeout(err) {
-			log.Errorf("Timeout detected, %s", err)
-		} else {
-			log.Errorf("Error submitting payload: %s", err)
+		return err
+	}
+	l.localCfg = cfg
+	updateRemoteSettingsInfo(l.remote.entries(cfg))
+	cfg = l.remote.apply(cfg)
+	l.cfg.Store(cfg)
+	updateInfoConfig(cfg)
+
+	l.forwardersMu.Lock()
+	previous := l.forwarders
+	l.forwarders = forwarders
+	l.forwardersMu.Unlock()
+	closeForwarders(previous)
+
+	l.runnersMu.Lock()
+	defer l.runnersMu.Unlock()
+	if l.exit == nil {
+		// The checks are started from the new configuration once running.
+		return nil
+	}
+	l.applyChecks(cfg)
+	return nil
+}
+
+// applyChecks stops the checks no longer enabled and starts the newly enabled
+// ones. The checks which stay enabled keep their state, only their interval is
+// updated. The caller must hold runnersMu.
+func (l *Collector) applyChecks(cfg *config.AgentConfig) {
+	var started, stopped []string
+	for _, c := range l.checks {
+		r, running := l.runners[c.Name()]
+		switch {
+		case cfg.CheckIsEnabled(c.Name()) && (!running || isStopped(r)):
+			// The interval of the real-time checks is set by the intake.
+			interval := cfg.CheckInterval(c.Name())
+			if c.RealTime() {
+				interval = l.realTimeInterval
+			}
+			l.startCheck(c, interval, r)
+			started = append(started, c.Name())
+		case !cfg.CheckIsEnabled(c.Name()) && running && !isStopped(r):
+			close(r.stop)
+			stopped = append(stopped, c.Name())
+		case running && !isStopped(r) && !c.RealTime():
+			if d := cfg.CheckInterval(c.Name()); d != r.interval {
+				log.Infof("Check interval of %s updated to %s", c.Name(), d)
+				r.interval = d
+				r.setInterval(d)
+			}
 		}
+	}
+	log.Infof("Configuration updated, enabled checks=%v, started=%v, stopped=%v", cfg.EnabledChecks, started, stopped)
+}
+
+// handleReload reloads the configuration on POST requests, like SIGHUP does.
+func (l *Collector) handleReload(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := l.reloadConfig(); err != nil {
+		log.Errorf("Unable to reload the configuration: %s", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	fmt.Fprintln(w, "configuration reloaded")
+}
+
+func isStopped(r *checkRunner) bool {
+	select {
+	case <-r.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func closeForwarders(forwarders map[string]forwarder.Forwarder) {
+	for _, f := range forwarders {
+		if err := f.Close(); err != nil {
+			log.Errorf("Error closing %s: %s", f.Name(), err)
+		}
+	}
+}
 
-	defer resp.Body.Close()
-	if resp.StatusCode < 200 || resp.StatusCode > 300 {
-		log.Errorf("unexpected response from %s. Status: %s", url, resp.Status)
-		io.Copy(ioutil.Discard, resp.Body)
+// refreshSystemInfo periodically collects the system info again so that
+// changes like CPU hotplug, memory resize or kernel updates are picked up.
+func (l *Collector) refreshSystemInfo(exit chan bool) {
+	cfg := l.agentConfig()
+	if cfg.SystemInfoInterval <= 0 {
 		return
 	}
+	ticker := time.NewTicker(cfg.SystemInfoInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			cur := l.sysInfo.Load().(*model.SystemInfo)
+			info, changed, err := checks.RefreshSystemInfo(l.agentConfig(), cur)
+			if err != nil {
+				log.Errorf("Unable to refresh system info: %s", err)
+				continue
+			}
+			if changed {
+				log.Infof("Detected a change of the system info, sequence is now %d", info.Sequence)
+				l.sysInfo.Store(info)
+			}
+		case _, ok := <-exit:
+			if !ok {
+				return
+			}
+		}
+	}
+}
 
-	body, err = ioutil.ReadAll(resp.Body)
-	if err != nil {
-		log.Errorf("could not decode response body: %s", err)
+// newForwarders creates a forwarder for each of the sinks in use.
+func newForwarders(cfg *config.AgentConfig) (map[string]forwarder.Forwarder, error) {
+	forwarders := make(map[string]forwarder.Forwarder)
+	if !cfg.OTLPOnly {
+		forwarders[config.SinkHTTP] = forwarder.NewHTTPForwarder(cfg, Version)
+	}
+	if cfg.UsesSink(config.SinkFile) {
+		f, err := forwarder.NewFileForwarder(cfg)
+		if err != nil {
+			return nil, err
+		}
+		forwarders[config.SinkFile] = f
+	}
+	return forwarders, nil
+}
+
+// queueOTLPExport hands the messages of a payload over to the OTLP export
+// routine, expiring the oldest payload when the queue is full.
+func (l *Collector) queueOTLPExport(messages []model.MessageBody) {
+	if l.otlpSend == nil {
 		return
 	}
+	for {
+		select {
+		case l.otlpSend <- messages:
+			return
+		default:
+			select {
+			case <-l.otlpSend:
+				log.Info("Expiring payload from OTLP export queue.")
+			default:
+			}
+		}
+	}
+}
 
-	r, err := model.DecodeMessage(body)
+// exportOTLP pushes the queued payloads to the OTLP collector until exit.
+func (l *Collector) exportOTLP(exit chan bool) {
+	for {
+		select {
+		case messages := <-l.otlpSend:
+			if err := l.otlpExporter.Export(messages); err != nil {
+				log.Errorf("Error exporting payload to OTLP: %s", err)
+			}
+		case <-exit:
+			return
+		}
+	}
+}
+
+// submitMessages sends the messages of a payload to each forwarder configured
+// for the endpoint. Only the messages submitted to the intake are delta encoded,
+// the exporters and the file sink getting the full payloads, which can be read
+// on their own.
+func (l *Collector) submitMessages(endpoint string, messages []model.MessageBody) {
+	cfg := l.agentConfig()
+	encoded := messages
+	if util.StringInSlice(cfg.EndpointSinks(endpoint), config.SinkHTTP) {
+		encoded = l.delta.encode(cfg, messages, time.Now())
+	}
+	for i, m := range messages {
+		l.submitMessage(endpoint, m, encoded[i])
+	}
+}
+
+// submitMessage sends the message to each forwarder configured for the endpoint,
+// the intake getting its delta encoded version.
+func (l *Collector) submitMessage(endpoint string, m, delta model.MessageBody) {
+	msg, err := model.NewMessage(model.MessageV3, model.MessageEncodingZstdPB, m)
 	if err != nil {
-		log.Errorf("could not decode response, invalid format: %s", err)
+		log.Errorf("Unable to detect message type: %s", err)
 		return
 	}
-	switch r.Header.Type {
-	case model.TypeResCollector:
-		rm := r.Body.(*model.ResCollector)
-		if len(rm.Message) > 0 {
-			log.Error(rm.Message)
-		} else {
-			l.updateStatus(rm.Status)
+	deltaMsg := msg
+	if delta != m {
+		if deltaMsg, err = model.NewMessage(model.MessageV3, model.MessageEncodingZstdPB, delta); err != nil {
+			log.Errorf("Unable to detect message type: %s", err)
+			return
 		}
-	default:
-		log.Errorf("unexpected response type: %d", r.Header.Type)
+	}
+
+	// The statuses are applied once the forwarders are released, as the remote
+	// settings are serialized with the reloads which swap the forwarders.
+	var statuses []*model.CollectorStatus
+	l.forwardersMu.RLock()
+	for _, sink := range l.agentConfig().EndpointSinks(endpoint) {
+		f, ok := l.forwarders[sink]
+		if !ok {
+			continue
+		}
+		submitted := msg
+		if sink == config.SinkHTTP {
+			submitted = deltaMsg
+		}
+		if err := f.Submit(endpoint, submitted); err != nil {
+			log.Errorf("Error submitting payload to %s: %s", f.Name(), err)
+			// The intake may have missed the metadata of new processes.
+			if _, ok := m.(*model.CollectorProc); ok && sink == config.SinkHTTP {
+				l.delta.requestResync()
+			}
+			continue
+		}
+		if s := f.Status().CollectorStatus; s != nil {
+			statuses = append(statuses, s)
+		}
+	}
+	l.forwardersMu.RUnlock()
+
+	for _, s := range statuses {
+		l.updateStatus(s)
 	}
 }
 
 func (l *Collector) updateStatus(s *model.CollectorStatus) {
+	if s.Settings != nil {
+		l.updateRemoteSettings(s.Settings)
+	}
+	if s.Resync {
+		log.Debug("Resync requested, sending the full metadata of every process")
+		l.delta.requestResync()
+	}
+
 	curEnabled := atomic.LoadInt64(&l.realTimeEnabled) == 1
-	if s.ActiveClients > 0 && !curEnabled && l.cfg.AllowRealTime {
+	if s.ActiveClients > 0 && !curEnabled && l.agentConfig().AllowRealTime {
 		log.Infof("Detected %d clients, enabling real-time mode", s.ActiveClients)
 		atomic.StoreInt64(&l.realTimeEnabled, 1)
 	} else if s.ActiveClients == 0 && curEnabled {
@@ -231,16 +568,19 @@ func (l *Collector) updateStatus(s *model.CollectorStatus) {
 		atomic.StoreInt64(&l.realTimeEnabled, 0)
 	}
 
+	l.runnersMu.Lock()
+	defer l.runnersMu.Unlock()
 	interval := time.Duration(s.Interval) * time.Second
 	if interval != l.realTimeInterval {
 		l.realTimeInterval = interval
 		if l.realTimeInterval <= 0 {
 			l.realTimeInterval = 2 * time.Second
 		}
-		// Pass along the real-time interval, one per check, so that every
-		// check routine will see the new interval.
-		for range l.enabledChecks {
-			l.rtIntervalCh <- l.realTimeInterval
+		// Pass along the real-time interval to every real-time check routine.
+		for _, c := range l.checks {
+			if r, ok := l.runners[c.Name()]; ok && c.RealTime() {
+				r.setInterval(l.realTimeInterval)
+			}
 		}
 		log.Infof("real time interval updated to %s", l.realTimeInterval)
 	}
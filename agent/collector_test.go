@@ -0,0 +1,281 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/forwarder"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// fakeForwarder records the submitted messages and returns a fixed status.
+type fakeForwarder struct {
+	submitted []string
+	messages  []model.Message
+	status    forwarder.Status
+}
+
+func (f *fakeForwarder) Name() string { return "fake" }
+
+func (f *fakeForwarder) Submit(endpoint string, m model.Message) error {
+	f.submitted = append(f.submitted, endpoint)
+	f.messages = append(f.messages, m)
+	return nil
+}
+
+func (f *fakeForwarder) Flush() error { return nil }
+
+func (f *fakeForwarder) Close() error { return nil }
+
+func (f *fakeForwarder) Status() forwarder.Status { return f.status }
+
+func newTestCollector(cfg *config.AgentConfig, chs []checks.Check) *Collector {
+	cfgValue := &atomic.Value{}
+	cfgValue.Store(cfg)
+	sysInfo := &atomic.Value{}
+	sysInfo.Store(&model.SystemInfo{Sequence: 1})
+	return &Collector{
+		send:             make(chan checkPayload, cfg.QueueSize),
+		cfg:              cfgValue,
+		localCfg:         cfg,
+		delta:            newDeltaEncoder(),
+		reloadMu:         &sync.Mutex{},
+		sysInfo:          sysInfo,
+		forwarders:       make(map[string]forwarder.Forwarder),
+		forwardersMu:     &sync.RWMutex{},
+		checks:           chs,
+		runners:          make(map[string]*checkRunner),
+		runnersMu:        &sync.Mutex{},
+		realTimeInterval: 2 * time.Second,
+	}
+}
+
+// fakeCheck counts its initializations and runs.
+type fakeCheck struct {
+	name string
+	mu   sync.Mutex
+	init int
+	runs int
+}
+
+func (c *fakeCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.init++
+}
+
+func (c *fakeCheck) Name() string     { return c.name }
+func (c *fakeCheck) Endpoint() string { return "/api/v1/" + c.name }
+func (c *fakeCheck) RealTime() bool   { return false }
+
+func (c *fakeCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.runs++
+	return nil, nil
+}
+
+func (c *fakeCheck) counts() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.init, c.runs
+}
+
+func TestSubmitMessage(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.PayloadSinks = map[string][]string{
+		"*":                   {config.SinkHTTP},
+		"/api/v1/connections": {config.SinkHTTP, config.SinkFile},
+	}
+	intake := &fakeForwarder{status: forwarder.Status{
+		CollectorStatus: &model.CollectorStatus{ActiveClients: 1, Interval: 2},
+	}}
+	file := &fakeForwarder{}
+	l := newTestCollector(cfg, nil)
+	l.forwarders = map[string]forwarder.Forwarder{
+		config.SinkHTTP: intake,
+		config.SinkFile: file,
+	}
+
+	l.submitMessages("/api/v1/collector", []model.MessageBody{&model.CollectorProc{}})
+	l.submitMessages("/api/v1/connections", []model.MessageBody{&model.CollectorConnections{}})
+
+	assert.Equal(t, []string{"/api/v1/collector", "/api/v1/connections"}, intake.submitted)
+	assert.Equal(t, []string{"/api/v1/connections"}, file.submitted)
+	// The status returned by the intake enabled real-time mode
+	assert.Equal(t, int64(1), l.realTimeEnabled)
+}
+
+func TestSubmitMessagesDelta(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessDelta = true
+	cfg.PayloadSinks = map[string][]string{"*": {config.SinkHTTP, config.SinkFile}}
+	intake, file := &fakeForwarder{}, &fakeForwarder{}
+	l := newTestCollector(cfg, nil)
+	l.forwarders = map[string]forwarder.Forwarder{
+		config.SinkHTTP: intake,
+		config.SinkFile: file,
+	}
+	payload := []model.MessageBody{&model.CollectorProc{Processes: []*model.Process{{Pid: 1, Command: &model.Command{Args: []string{"nginx"}}}}}}
+
+	l.submitMessages("/api/v1/collector", payload)
+	l.submitMessages("/api/v1/collector", payload)
+
+	// Only the intake gets the delta encoded payloads
+	if assert.Len(t, intake.messages, 2) {
+		msg := intake.messages[1].Body.(*model.CollectorProc)
+		assert.True(t, msg.Delta)
+		assert.Nil(t, msg.Processes[0].Command)
+	}
+	if assert.Len(t, file.messages, 2) {
+		for _, m := range file.messages {
+			msg := m.Body.(*model.CollectorProc)
+			assert.False(t, msg.Delta)
+			assert.NotNil(t, msg.Processes[0].Command)
+		}
+	}
+
+	// Nothing is delta encoded without the intake
+	cfg.PayloadSinks = map[string][]string{"*": {config.SinkFile}}
+	l.delta.requestResync()
+	l.submitMessages("/api/v1/collector", payload)
+	l.submitMessages("/api/v1/collector", payload)
+	if assert.Len(t, file.messages, 4) {
+		assert.False(t, file.messages[3].Body.(*model.CollectorProc).Delta)
+	}
+}
+
+func TestQueueOTLPExport(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	l := newTestCollector(cfg, nil)
+	// Nothing is queued when the exporter is disabled
+	l.queueOTLPExport([]model.MessageBody{&model.CollectorProc{}})
+
+	// The oldest payload expires when the queue is full, the newest never blocks
+	l.otlpSend = make(chan []model.MessageBody, 2)
+	first := []model.MessageBody{&model.CollectorProc{GroupId: 1}}
+	second := []model.MessageBody{&model.CollectorProc{GroupId: 2}}
+	third := []model.MessageBody{&model.CollectorProc{GroupId: 3}}
+	l.queueOTLPExport(first)
+	l.queueOTLPExport(second)
+	l.queueOTLPExport(third)
+	assert.Len(t, l.otlpSend, 2)
+	assert.Equal(t, second, <-l.otlpSend)
+	assert.Equal(t, third, <-l.otlpSend)
+}
+
+func TestUpdateStatus(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	l := newTestCollector(cfg, []checks.Check{checks.Process, checks.RTProcess, checks.RTContainer})
+	for _, c := range l.checks[:2] {
+		l.runners[c.Name()] = &checkRunner{intervalCh: make(chan time.Duration, 1)}
+	}
+
+	// Same interval, only the real-time toggle changes
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 2, Interval: 2})
+	assert.Equal(t, int64(1), l.realTimeEnabled)
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2})
+	assert.Equal(t, int64(0), l.realTimeEnabled)
+
+	// A new interval is passed along to the running real-time checks, the
+	// latest one replacing a pending one.
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 1, Interval: 4})
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 1, Interval: 5})
+	assert.Len(t, l.runners["process"].intervalCh, 0)
+	assert.Equal(t, 5*time.Second, <-l.runners["rtprocess"].intervalCh)
+	assert.Equal(t, 5*time.Second, l.realTimeInterval)
+
+	// Real-time mode is never enabled when it's not allowed
+	cfg.AllowRealTime = false
+	l.realTimeEnabled = 0
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 1, Interval: 5})
+	assert.Equal(t, int64(0), l.realTimeEnabled)
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "process-agent-reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	a, b := &fakeCheck{name: "a"}, &fakeCheck{name: "b"}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.EnabledChecks = []string{"a"}
+	cfg.CheckIntervals = map[string]time.Duration{"a": time.Hour, "b": time.Hour}
+	l := newTestCollector(cfg, []checks.Check{a, b})
+	intake := &fakeForwarder{}
+	l.forwarders[config.SinkHTTP] = intake
+
+	exit := make(chan bool)
+	defer close(exit)
+	go l.run(exit)
+	// Drain the payloads of the checks
+	go func() {
+		for {
+			select {
+			case <-l.send:
+			case <-exit:
+				return
+			}
+		}
+	}()
+	waitFor := func(c *fakeCheck, init, runs int) {
+		for i := 0; i < 100; i++ {
+			if ci, cr := c.counts(); ci == init && cr == runs {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		ci, cr := c.counts()
+		t.Fatalf("check %s initialized %d times and ran %d times, expected %d and %d", c.name, ci, cr, init, runs)
+	}
+	// Checks are initialized and primed when started
+	waitFor(a, 1, 1)
+
+	// Check b replaces check a, which keeps its state when it's enabled back
+	cfg2 := config.NewDefaultAgentConfig()
+	cfg2.EnabledChecks = []string{"b"}
+	cfg2.CheckIntervals = cfg.CheckIntervals
+	cfg2.PayloadSinks = map[string][]string{"*": {config.SinkFile}}
+	cfg2.FileSinkDir = dir
+	assert.NoError(t, l.reload(cfg2))
+	waitFor(b, 1, 1)
+	assert.Equal(t, cfg2, l.agentConfig())
+	assert.Contains(t, l.forwarders, config.SinkFile)
+	assert.True(t, isStopped(l.runners["a"]))
+	<-l.runners["a"].done
+
+	// The interval of a running check is updated live
+	cfg3 := config.NewDefaultAgentConfig()
+	cfg3.EnabledChecks = []string{"a", "b"}
+	cfg3.CheckIntervals = map[string]time.Duration{"a": time.Hour, "b": 10 * time.Millisecond}
+	assert.NoError(t, l.reload(cfg3))
+	waitFor(a, 2, 2)
+	for i := 0; i < 100; i++ {
+		if _, runs := b.counts(); runs > 2 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	init, runs := b.counts()
+	assert.Equal(t, 1, init)
+	assert.True(t, runs > 2)
+
+	// An invalid configuration is not applied
+	cfg4 := config.NewDefaultAgentConfig()
+	cfg4.PayloadSinks = map[string][]string{"*": {config.SinkFile}}
+	cfg4.FileSinkDir = dir
+	cfg4.FileSinkFormat = "xml"
+	assert.Error(t, l.reload(cfg4))
+	assert.Equal(t, cfg3, l.agentConfig())
+	assert.False(t, isStopped(l.runners["a"]))
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+// runConfigCheck validates the configuration files and the environment, then
+// prints the problems found and the effective configuration. It returns false
+// when there is any problem.
+func runConfigCheck(iniPath, yamlPath string, out io.Writer) (bool, error) {
+	res, err := config.CheckConfig(iniPath, yamlPath)
+	if err != nil {
+		return false, err
+	}
+
+	if len(res.Problems) == 0 {
+		fmt.Fprintf(out, "No problem found in %s, %s and the environment\n\n", iniPath, yamlPath)
+	} else {
+		fmt.Fprintf(out, "%d problem(s) found:\n", len(res.Problems))
+		for _, p := range res.Problems {
+			fmt.Fprintf(out, "  %s\n", p)
+		}
+		fmt.Fprintln(out)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "FIELD\tSOURCE\tVALUE\n")
+	for _, f := range res.Fields {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", f.Name, f.Source, f.Value)
+	}
+	return len(res.Problems) == 0, w.Flush()
+}
+
+// exitConfigCheck runs the configcheck subcommand and exits, with an error
+// status when the configuration has problems.
+func exitConfigCheck() {
+	// The config loading logs what it overrides, only the report is printed.
+	log.ReplaceLogger(log.Disabled)
+
+	ok, err := runConfigCheck(opts.ddConfigPath, opts.configPath, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
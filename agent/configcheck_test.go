@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConfigCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "process-agent-configcheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	yamlPath := filepath.Join(dir, "datadog.yaml")
+	missing := filepath.Join(dir, "datadog.conf")
+
+	var out bytes.Buffer
+	ioutil.WriteFile(yamlPath, []byte("api_key: abcdefghij\nprocess_config:\n  queue_size: 7\n"), 0644)
+	ok, err := runConfigCheck(missing, yamlPath, &out)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(out.String(), "No problem found"))
+	assert.Contains(t, out.String(), "QueueSize                 yaml     7\n")
+	assert.Contains(t, out.String(), "*****fghij")
+	assert.NotContains(t, out.String(), "abcdefghij")
+
+	out.Reset()
+	ioutil.WriteFile(yamlPath, []byte("process_config:\n  queue_sise: 7\n"), 0644)
+	ok, err = runConfigCheck(missing, yamlPath, &out)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, out.String(), "1 problem(s) found:\n  "+yamlPath+":2: process_config.queue_sise: unknown key\n")
+}
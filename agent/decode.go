@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const decodeUsage = `Usage: process-agent decode [options] [file...]
+
+Decodes V1-V3 encoded messages, either raw intake bodies or the files written
+by the binary file sink, and prints them. Reads stdin when no file is given.
+
+Options:
+`
+
+var messageTypeNames = map[model.MessageType]string{
+	model.TypeCollectorProc:              "CollectorProc",
+	model.TypeCollectorConnections:       "CollectorConnections",
+	model.TypeResCollector:               "ResCollector",
+	model.TypeCollectorRealTime:          "CollectorRealTime",
+	model.TypeCollectorContainer:         "CollectorContainer",
+	model.TypeCollectorContainerRealTime: "CollectorContainerRealTime",
+	model.TypeCollectorSystemStats:       "CollectorSystemStats",
+}
+
+// decodedHeader is the JSON representation of a model.MessageHeader.
+type decodedHeader struct {
+	Version        model.MessageVersion  `json:"version"`
+	Encoding       model.MessageEncoding `json:"encoding"`
+	Type           model.MessageType     `json:"type"`
+	TypeName       string                `json:"typeName"`
+	SubscriptionID uint8                 `json:"subscriptionId"`
+	OrgID          int32                 `json:"orgId"`
+	Timestamp      int64                 `json:"timestamp"`
+}
+
+type decodedMessage struct {
+	Header decodedHeader   `json:"header"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// runDecode runs the decode subcommand with the given arguments.
+func runDecode(args []string, stdin io.Reader, out io.Writer) error {
+	fs := flag.NewFlagSet("decode", flag.ContinueOnError)
+	fs.SetOutput(out)
+	format := fs.String("format", "json", "Output format, json or table")
+	summary := fs.Bool("summary", false, "Only print the number of messages, processes and containers")
+	fs.Usage = func() {
+		fmt.Fprint(out, decodeUsage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "json" && *format != "table" {
+		return fmt.Errorf("invalid format '%s', choose from: json, table", *format)
+	}
+
+	var msgs []model.Message
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		inputs = []string{"-"}
+	}
+	for _, in := range inputs {
+		var data []byte
+		var err error
+		if in == "-" {
+			data, err = ioutil.ReadAll(stdin)
+		} else {
+			data, err = ioutil.ReadFile(in)
+		}
+		if err != nil {
+			return err
+		}
+		decoded, err := decodeMessages(data)
+		if err != nil {
+			return fmt.Errorf("%s: %s", in, err)
+		}
+		msgs = append(msgs, decoded...)
+	}
+
+	if *summary {
+		return printSummary(out, msgs)
+	}
+	for _, m := range msgs {
+		var err error
+		if *format == "table" {
+			err = printMessageTable(out, m)
+		} else {
+			err = printMessageJSON(out, m)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeMessages decodes every message of data. Raw intake bodies start with
+// their version byte whereas the binary file sink prefixes each message with
+// its big-endian length, whose first byte is 0 for any realistic message.
+func decodeMessages(data []byte) ([]model.Message, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no message found")
+	}
+	if data[0] != 0 {
+		m, err := model.DecodeMessage(data)
+		if err != nil {
+			return nil, err
+		}
+		return []model.Message{m}, nil
+	}
+
+	var msgs []model.Message
+	for offset := 0; offset < len(data); {
+		if len(data)-offset < 4 {
+			return nil, fmt.Errorf("truncated length at offset %d", offset)
+		}
+		n := int(binary.BigEndian.Uint32(data[offset:]))
+		offset += 4
+		if len(data)-offset < n {
+			return nil, fmt.Errorf("truncated message at offset %d", offset)
+		}
+		m, err := model.DecodeMessage(data[offset : offset+n])
+		if err != nil {
+			return nil, fmt.Errorf("message at offset %d: %s", offset, err)
+		}
+		msgs = append(msgs, m)
+		offset += n
+	}
+	return msgs, nil
+}
+
+func newDecodedHeader(h model.MessageHeader) decodedHeader {
+	return decodedHeader{
+		Version:        h.Version,
+		Encoding:       h.Encoding,
+		Type:           h.Type,
+		TypeName:       messageTypeNames[h.Type],
+		SubscriptionID: h.SubscriptionID,
+		OrgID:          h.OrgID,
+		Timestamp:      h.Timestamp,
+	}
+}
+
+func printMessageJSON(out io.Writer, m model.Message) error {
+	var body bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&body, m.Body); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(decodedMessage{newDecodedHeader(m.Header), body.Bytes()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(b))
+	return err
+}
+
+func printMessageTable(out io.Writer, m model.Message) error {
+	h := newDecodedHeader(m.Header)
+	fmt.Fprintf(out, "%s (type=%d version=%d encoding=%d org=%d timestamp=%d)\n",
+		h.TypeName, h.Type, h.Version, h.Encoding, h.OrgID, h.Timestamp)
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	switch body := m.Body.(type) {
+	case *model.CollectorProc:
+		fmt.Fprintf(w, "PID\tUSER\tCPU%%\tRSS\tCONTAINER\tCOMMAND\n")
+		for _, p := range body.Processes {
+			var user, cmdline string
+			if p.User != nil {
+				user = p.User.Name
+			}
+			if p.Command != nil {
+				cmdline = strings.Join(p.Command.Args, " ")
+			}
+			var cpu float32
+			if p.Cpu != nil {
+				cpu = p.Cpu.TotalPct
+			}
+			var rss uint64
+			if p.Memory != nil {
+				rss = p.Memory.Rss
+			}
+			fmt.Fprintf(w, "%d\t%s\t%.1f\t%d\t%s\t%s\n", p.Pid, user, cpu, rss, p.ContainerId, cmdline)
+		}
+		writeOmitted(w, body.Omitted)
+		if body.Delta {
+			fmt.Fprintf(w, "(delta payload, unchanged commands and users left out)\n")
+		}
+		writeContainers(w, body.Containers)
+	case *model.CollectorContainer:
+		writeContainers(w, body.Containers)
+	case *model.CollectorRealTime:
+		fmt.Fprintf(w, "PID\tCPU%%\tRSS\tTHREADS\tCONTAINER\n")
+		for _, s := range body.Stats {
+			var cpu float32
+			if s.Cpu != nil {
+				cpu = s.Cpu.TotalPct
+			}
+			var rss uint64
+			if s.Memory != nil {
+				rss = s.Memory.Rss
+			}
+			fmt.Fprintf(w, "%d\t%.1f\t%d\t%d\t%s\n", s.Pid, cpu, rss, s.Threads, s.ContainerId)
+		}
+		writeOmitted(w, body.Omitted)
+		writeContainerStats(w, body.ContainerStats)
+	case *model.CollectorContainerRealTime:
+		writeContainerStats(w, body.Stats)
+	case *model.CollectorConnections:
+		fmt.Fprintf(w, "PID\tLOCAL\tREMOTE\tSTATUS\n")
+		for _, c := range body.Connections {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", c.Pid, formatAddr(c.Laddr), formatAddr(c.Raddr), c.Status)
+		}
+	case *model.ResCollector:
+		fmt.Fprintf(w, "MESSAGE\tACTIVE CLIENTS\tINTERVAL\n")
+		var clients, interval int32
+		if body.Status != nil {
+			clients, interval = body.Status.ActiveClients, body.Status.Interval
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\n", body.Message, clients, interval)
+	default:
+		// Other messages don't have a tabular layout, fall back on JSON.
+		w.Flush()
+		return printMessageJSON(out, m)
+	}
+	fmt.Fprintln(w)
+	return w.Flush()
+}
+
+// writeOmitted writes the summary of the processes left out by the sampling.
+func writeOmitted(w io.Writer, o *model.OmittedProcesses) {
+	if o == nil {
+		return
+	}
+	fmt.Fprintf(w, "(%d processes omitted, %.1f%% CPU, %d RSS)\n", o.Count, o.TotalCpuPct, o.TotalRss)
+}
+
+func writeContainers(w io.Writer, ctrs []*model.Container) {
+	if len(ctrs) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nCONTAINER\tTYPE\tIMAGE\tCPU%%\tRSS\n")
+	for _, c := range ctrs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.1f\t%d\n", c.Id, c.Type, c.Image, c.TotalPct, c.MemRss)
+	}
+}
+
+func writeContainerStats(w io.Writer, stats []*model.ContainerStat) {
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nCONTAINER\tCPU%%\tRSS\tSTATE\n")
+	for _, s := range stats {
+		fmt.Fprintf(w, "%s\t%.1f\t%d\t%s\n", s.Id, s.TotalPct, s.MemRss, s.State)
+	}
+}
+
+func formatAddr(a *model.Addr) string {
+	if a == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", a.Ip, a.Port)
+}
+
+// printSummary prints the number of messages by type and the number of
+// processes for each container, "-" counting the processes out of containers.
+func printSummary(out io.Writer, msgs []model.Message) error {
+	byType := make(map[string]int)
+	procsByContainer := make(map[string]int)
+	containers := make(map[string]struct{})
+	procs := 0
+	countProc := func(containerID string) {
+		procs++
+		if containerID == "" {
+			containerID = "-"
+		}
+		procsByContainer[containerID]++
+	}
+	for _, m := range msgs {
+		byType[newDecodedHeader(m.Header).TypeName]++
+		switch body := m.Body.(type) {
+		case *model.CollectorProc:
+			for _, p := range body.Processes {
+				countProc(p.ContainerId)
+			}
+			for _, c := range body.Containers {
+				containers[c.Id] = struct{}{}
+			}
+		case *model.CollectorRealTime:
+			for _, s := range body.Stats {
+				countProc(s.ContainerId)
+			}
+			for _, c := range body.ContainerStats {
+				containers[c.Id] = struct{}{}
+			}
+		case *model.CollectorContainer:
+			for _, c := range body.Containers {
+				containers[c.Id] = struct{}{}
+			}
+		case *model.CollectorContainerRealTime:
+			for _, c := range body.Stats {
+				containers[c.Id] = struct{}{}
+			}
+		}
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Messages:\t%d\n", len(msgs))
+	for _, name := range sortedKeys(byType) {
+		fmt.Fprintf(w, "  %s:\t%d\n", name, byType[name])
+	}
+	fmt.Fprintf(w, "Processes:\t%d\n", procs)
+	fmt.Fprintf(w, "Containers:\t%d\n", len(containers))
+	if len(procsByContainer) > 0 {
+		fmt.Fprintf(w, "\nCONTAINER\tPROCESSES\n")
+		for _, id := range sortedKeys(procsByContainer) {
+			fmt.Fprintf(w, "%s\t%d\n", id, procsByContainer[id])
+		}
+	}
+	return w.Flush()
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// exitDecode runs the decode subcommand with the command-line arguments and exits.
+func exitDecode(args []string) {
+	if err := runDecode(args, os.Stdin, os.Stdout); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func encodeTestMessage(t *testing.T, version model.MessageVersion, body model.MessageBody) []byte {
+	m, err := model.NewMessage(version, model.MessageEncodingZstdPB, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Header.Timestamp = 1519898400000
+	data, err := model.EncodeMessage(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func testProcMessage() *model.CollectorProc {
+	return &model.CollectorProc{
+		HostName: "my-host",
+		Processes: []*model.Process{
+			{Pid: 1, Command: &model.Command{Args: []string{"/sbin/init"}}, User: &model.ProcessUser{Name: "root"}},
+			{Pid: 42, Command: &model.Command{Args: []string{"redis-server", "*:6379"}}, ContainerId: "abc"},
+			{Pid: 43, ContainerId: "abc"},
+		},
+		Containers: []*model.Container{{Id: "abc", Type: "docker", Image: "redis:4"}},
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	var out bytes.Buffer
+	in := bytes.NewReader(encodeTestMessage(t, model.MessageV3, testProcMessage()))
+	assert.NoError(t, runDecode(nil, in, &out))
+
+	var decoded struct {
+		Header decodedHeader
+		Body   struct {
+			HostName  string
+			Processes []struct{ Pid int32 }
+		}
+	}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, model.MessageVersion(model.MessageV3), decoded.Header.Version)
+	assert.Equal(t, model.MessageEncodingZstdPB, decoded.Header.Encoding)
+	assert.Equal(t, "CollectorProc", decoded.Header.TypeName)
+	assert.Equal(t, int64(1519898400000), decoded.Header.Timestamp)
+	assert.Equal(t, "my-host", decoded.Body.HostName)
+	assert.Len(t, decoded.Body.Processes, 3)
+}
+
+func TestDecodeTable(t *testing.T) {
+	var out bytes.Buffer
+	in := bytes.NewReader(encodeTestMessage(t, model.MessageV1, testProcMessage()))
+	assert.NoError(t, runDecode([]string{"-format", "table"}, in, &out))
+
+	lines := strings.Split(out.String(), "\n")
+	assert.Equal(t, "CollectorProc (type=12 version=1 encoding=2 org=0 timestamp=0)", lines[0])
+	assert.Contains(t, out.String(), "42   ")
+	assert.Contains(t, out.String(), "redis-server *:6379")
+	assert.Contains(t, out.String(), "redis:4")
+}
+
+func TestDecodeSummary(t *testing.T) {
+	// Messages as written by the binary file sink
+	var in bytes.Buffer
+	for _, body := range []model.MessageBody{
+		testProcMessage(),
+		&model.CollectorRealTime{Stats: []*model.ProcessStat{{Pid: 1}, {Pid: 42, ContainerId: "abc"}}},
+		&model.CollectorContainer{Containers: []*model.Container{{Id: "def"}}},
+	} {
+		data := encodeTestMessage(t, model.MessageV3, body)
+		binary.Write(&in, binary.BigEndian, uint32(len(data)))
+		in.Write(data)
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, runDecode([]string{"-summary"}, &in, &out))
+	assert.Equal(t, strings.Join([]string{
+		"Messages:              3",
+		"  CollectorContainer:  1",
+		"  CollectorProc:       1",
+		"  CollectorRealTime:   1",
+		"Processes:             5",
+		"Containers:            2",
+		"",
+		"CONTAINER  PROCESSES",
+		"-          2",
+		"abc        3",
+		"",
+	}, "\n"), out.String())
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var out bytes.Buffer
+	assert.Error(t, runDecode(nil, bytes.NewReader(nil), &out))
+	assert.Error(t, runDecode(nil, bytes.NewReader([]byte{9, 0, 0, 0, 0}), &out))
+	// Truncated file sink output
+	assert.Error(t, runDecode(nil, bytes.NewReader([]byte{0, 0, 0, 10, 3}), &out))
+	assert.Error(t, runDecode([]string{"-format", "xml"}, bytes.NewReader(nil), &out))
+	assert.Error(t, runDecode([]string{"/does/not/exist"}, nil, &out))
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// deltaEncoder strips from the process payloads the command and user of the
+// processes which didn't change since the previous payload, when
+// cfg.ProcessDelta is set. A payload carries them all again when delta mode was
+// just enabled, every cfg.ProcessDeltaResync, and after a resync is requested,
+// on intake request or when a payload couldn't be submitted.
+type deltaEncoder struct {
+	mu sync.Mutex
+	// The digests of the processes of the previous payload, nil when the next
+	// payload is a full one.
+	sent     map[processKey]processDigest
+	lastFull time.Time
+}
+
+// processKey identifies a process across runs, as pids get reused.
+type processKey struct {
+	pid        int32
+	createTime int64
+}
+
+type processDigest struct {
+	command uint64
+	user    uint64
+}
+
+func newDeltaEncoder() *deltaEncoder {
+	return &deltaEncoder{}
+}
+
+// requestResync makes the next process payload a full one.
+func (d *deltaEncoder) requestResync() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sent = nil
+}
+
+// encode returns the messages of a payload to submit. The messages given are
+// left untouched, as the exporters share them.
+func (d *deltaEncoder) encode(cfg *config.AgentConfig, messages []model.MessageBody, now time.Time) []model.MessageBody {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !cfg.ProcessDelta {
+		d.sent = nil
+		return messages
+	}
+	if !hasProcesses(messages) {
+		return messages
+	}
+
+	full := d.sent == nil || (cfg.ProcessDeltaResync > 0 && now.Sub(d.lastFull) >= cfg.ProcessDeltaResync)
+	if full {
+		d.lastFull = now
+	}
+	sent := make(map[processKey]processDigest, len(d.sent))
+	encoded := make([]model.MessageBody, 0, len(messages))
+	for _, m := range messages {
+		msg, ok := m.(*model.CollectorProc)
+		if !ok {
+			encoded = append(encoded, m)
+			continue
+		}
+		delta := *msg
+		delta.Delta = !full
+		delta.Processes = make([]*model.Process, 0, len(msg.Processes))
+		for _, p := range msg.Processes {
+			key := processKey{p.Pid, p.CreateTime}
+			digest := digestProcess(p)
+			sent[key] = digest
+			if prev, ok := d.sent[key]; ok && !full {
+				stripped := *p
+				if prev.command == digest.command {
+					stripped.Command = nil
+				}
+				if prev.user == digest.user {
+					stripped.User = nil
+				}
+				p = &stripped
+			}
+			delta.Processes = append(delta.Processes, p)
+		}
+		encoded = append(encoded, &delta)
+	}
+	d.sent = sent
+	return encoded
+}
+
+func hasProcesses(messages []model.MessageBody) bool {
+	for _, m := range messages {
+		if _, ok := m.(*model.CollectorProc); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func digestProcess(p *model.Process) processDigest {
+	var d processDigest
+	if p.Command != nil {
+		b, _ := p.Command.Marshal()
+		d.command = digestBytes(b)
+	}
+	if p.User != nil {
+		b, _ := p.User.Marshal()
+		d.user = digestBytes(b)
+	}
+	return d
+}
+
+func digestBytes(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestDeltaEncoder(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessDelta = true
+	cfg.ProcessDeltaResync = time.Minute
+	proc := func(pid int32, createTime int64, args ...string) *model.Process {
+		return &model.Process{
+			Pid:        pid,
+			CreateTime: createTime,
+			Command:    &model.Command{Args: args},
+			User:       &model.ProcessUser{Name: "root"},
+		}
+	}
+	payload := func(procs ...*model.Process) []model.MessageBody {
+		return []model.MessageBody{&model.CollectorProc{Processes: procs}}
+	}
+	encoded := func(messages []model.MessageBody) *model.CollectorProc {
+		return messages[0].(*model.CollectorProc)
+	}
+	d := newDeltaEncoder()
+	now := time.Now()
+
+	// The first payload is a full one
+	first := payload(proc(1, 100, "nginx"), proc(2, 100, "redis"))
+	msg := encoded(d.encode(cfg, first, now))
+	assert.False(t, msg.Delta)
+	assert.Equal(t, first[0], msg)
+
+	// Only the changed metadata and the new processes are sent after, a reused
+	// pid being a new process
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx"), proc(2, 200, "redis"), proc(3, 100, "sh")), now.Add(10*time.Second)))
+	assert.True(t, msg.Delta)
+	assert.Nil(t, msg.Processes[0].Command)
+	assert.Nil(t, msg.Processes[0].User)
+	assert.Equal(t, []string{"redis"}, msg.Processes[1].Command.Args)
+	assert.Equal(t, []string{"sh"}, msg.Processes[2].Command.Args)
+
+	second := payload(proc(1, 100, "nginx", "-s", "reload"), proc(2, 200, "redis"))
+	msg = encoded(d.encode(cfg, second, now.Add(20*time.Second)))
+	assert.True(t, msg.Delta)
+	assert.Equal(t, []string{"nginx", "-s", "reload"}, msg.Processes[0].Command.Args)
+	assert.Nil(t, msg.Processes[0].User)
+	assert.Nil(t, msg.Processes[1].Command)
+	// The messages given are left untouched
+	assert.NotNil(t, encoded(second).Processes[1].Command)
+	assert.False(t, encoded(second).Delta)
+
+	// A resync is requested, or is due
+	d.requestResync()
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx")), now.Add(30*time.Second)))
+	assert.False(t, msg.Delta)
+	assert.NotNil(t, msg.Processes[0].Command)
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx")), now.Add(40*time.Second)))
+	assert.True(t, msg.Delta)
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx")), now.Add(90*time.Second)))
+	assert.False(t, msg.Delta)
+
+	// The other payloads are passed along
+	rt := []model.MessageBody{&model.CollectorRealTime{}}
+	assert.Equal(t, rt, d.encode(cfg, rt, now.Add(100*time.Second)))
+
+	// Disabling delta mode makes the next payload a full one once enabled again
+	cfg.ProcessDelta = false
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx")), now.Add(110*time.Second)))
+	assert.False(t, msg.Delta)
+	cfg.ProcessDelta = true
+	msg = encoded(d.encode(cfg, payload(proc(1, 100, "nginx")), now.Add(120*time.Second)))
+	assert.False(t, msg.Delta)
+	assert.NotNil(t, msg.Processes[0].Command)
+}
+
+func TestUpdateStatusResync(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessDelta = true
+	l := newTestCollector(cfg, nil)
+	messages := []model.MessageBody{&model.CollectorProc{Processes: []*model.Process{{Pid: 1, Command: &model.Command{}}}}}
+
+	l.delta.encode(cfg, messages, time.Now())
+	assert.True(t, l.delta.encode(cfg, messages, time.Now())[0].(*model.CollectorProc).Delta)
+	l.updateStatus(&model.CollectorStatus{Interval: 2, Resync: true})
+	assert.False(t, l.delta.encode(cfg, messages, time.Now())[0].(*model.CollectorProc).Delta)
+}
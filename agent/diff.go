@@ -0,0 +1,439 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const diffUsage = `Usage: process-agent diff [options] [before after]
+
+Compares two snapshots of the processes and reports the processes started,
+exited, restarted (same command line, new pid), the changes of user, working
+directory and arguments, and the largest CPU and memory deltas.
+
+The snapshots are the CollectorProc messages of files written by the binary
+file sink or by --check process -format proto. Without files, the process check
+is run twice, -delay apart. The processes of delta encoded messages must follow
+a full message holding them in the same file. When the processes are sampled,
+the ones left out of a snapshot are reported as started or exited.
+
+Options:
+`
+
+// snapshotRunInterval is the time between the priming run of the process check
+// and the run taking a snapshot, the CPU usage being computed from both.
+var snapshotRunInterval = time.Second
+
+// diffProcess is a process as reported by the diff subcommand.
+type diffProcess struct {
+	Pid     int32  `json:"pid"`
+	User    string `json:"user"`
+	Command string `json:"command"`
+}
+
+type procRestart struct {
+	OldPid  int32  `json:"oldPid"`
+	NewPid  int32  `json:"newPid"`
+	Command string `json:"command"`
+}
+
+type procChange struct {
+	Pid    int32  `json:"pid"`
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// procDelta is the evolution of the CPU usage, in percent, and of the RSS, in
+// bytes, of a process between the snapshots.
+type procDelta struct {
+	Pid     int32   `json:"pid"`
+	Command string  `json:"command"`
+	CPU     float32 `json:"cpu"`
+	RSS     int64   `json:"rss"`
+}
+
+// snapshotDiff is the difference between two process snapshots.
+type snapshotDiff struct {
+	Started   []diffProcess `json:"started"`
+	Exited    []diffProcess `json:"exited"`
+	Restarted []procRestart `json:"restarted"`
+	Changed   []procChange  `json:"changed"`
+	TopCPU    []procDelta   `json:"topCpu"`
+	TopMemory []procDelta   `json:"topMemory"`
+}
+
+// runDiff runs the diff subcommand with the given arguments. The process check
+// is run when no snapshot file is given. Warnings are written to errOut.
+func runDiff(cfg *config.AgentConfig, ch checks.Check, args []string, out, errOut io.Writer) error {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(out)
+	format := fs.String("format", "text", "Output format, text or json")
+	delay := fs.Duration("delay", 10*time.Second, "Time between the two snapshots taken without files")
+	top := fs.Int("top", 10, "Number of processes reported with the largest CPU and memory deltas")
+	fs.Usage = func() {
+		fmt.Fprint(out, diffUsage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("invalid format '%s', choose from: text, json", *format)
+	}
+
+	var before, after snapshot
+	var err error
+	switch fs.NArg() {
+	case 2:
+		if before, err = readSnapshot(fs.Arg(0)); err != nil {
+			return err
+		}
+		if after, err = readSnapshot(fs.Arg(1)); err != nil {
+			return err
+		}
+	case 0:
+		if before, err = takeSnapshot(cfg, ch); err != nil {
+			return err
+		}
+		time.Sleep(*delay)
+		if after, err = takeSnapshot(cfg, ch); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("expected two snapshot files or none, got %d", fs.NArg())
+	}
+	for i, s := range []snapshot{before, after} {
+		if s.omitted > 0 {
+			fmt.Fprintf(errOut, "Warning: %d processes were sampled out of the %s snapshot, they may be reported as started or exited\n", s.omitted, []string{"first", "second"}[i])
+		}
+	}
+
+	d := diffSnapshots(before.procs, after.procs, *top)
+	if *format == "json" {
+		b, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	}
+	return printDiff(out, d)
+}
+
+// snapshot is the processes of a snapshot sorted by pid, with the number of
+// processes the sampling left out of it.
+type snapshot struct {
+	procs   []*model.Process
+	omitted int32
+}
+
+// readSnapshot returns the processes of every CollectorProc message of a file,
+// the last occurrence of a pid winning when the file holds several runs.
+func readSnapshot(path string) (snapshot, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return snapshot{}, err
+	}
+	msgs, err := decodeMessages(data)
+	if err != nil {
+		return snapshot{}, fmt.Errorf("%s: %s", path, err)
+	}
+	bodies := make([]model.MessageBody, 0, len(msgs))
+	for _, m := range msgs {
+		bodies = append(bodies, m.Body)
+	}
+	s, err := snapshotProcesses(bodies)
+	if err != nil {
+		return snapshot{}, fmt.Errorf("%s: %s", path, err)
+	}
+	if len(s.procs) == 0 {
+		return snapshot{}, fmt.Errorf("%s: no CollectorProc message found", path)
+	}
+	return s, nil
+}
+
+// takeSnapshot runs the process check twice, as processes are only reported
+// from their second run on.
+func takeSnapshot(cfg *config.AgentConfig, ch checks.Check) (snapshot, error) {
+	if _, err := ch.Run(cfg, 0); err != nil {
+		return snapshot{}, fmt.Errorf("collection error: %s", err)
+	}
+	time.Sleep(snapshotRunInterval)
+	msgs, err := ch.Run(cfg, 1)
+	if err != nil {
+		return snapshot{}, fmt.Errorf("collection error: %s", err)
+	}
+	return snapshotProcesses(msgs)
+}
+
+// snapshotProcesses returns the processes of the CollectorProc messages. The
+// processes of the delta encoded messages get the command and the user they
+// were sent without from a previous message, and the number of processes left
+// out is the one of the last sampled message.
+func snapshotProcesses(msgs []model.MessageBody) (snapshot, error) {
+	var s snapshot
+	byPid := make(map[int32]*model.Process)
+	full := make(map[processKey]*model.Process)
+	for _, m := range msgs {
+		msg, ok := m.(*model.CollectorProc)
+		if !ok {
+			continue
+		}
+		if msg.Omitted != nil {
+			s.omitted = msg.Omitted.Count
+		}
+		for _, p := range msg.Processes {
+			key := processKey{p.Pid, p.CreateTime}
+			if msg.Delta && (p.Command == nil || p.User == nil) {
+				prev, ok := full[key]
+				if !ok {
+					return snapshot{}, fmt.Errorf("delta encoded message without a previous full message for process %d", p.Pid)
+				}
+				merged := *p
+				if merged.Command == nil {
+					merged.Command = prev.Command
+				}
+				if merged.User == nil {
+					merged.User = prev.User
+				}
+				p = &merged
+			}
+			full[key] = p
+			byPid[p.Pid] = p
+		}
+	}
+	s.procs = make([]*model.Process, 0, len(byPid))
+	for _, p := range byPid {
+		s.procs = append(s.procs, p)
+	}
+	sort.Slice(s.procs, func(i, j int) bool { return s.procs[i].Pid < s.procs[j].Pid })
+	return s, nil
+}
+
+// diffSnapshots compares two snapshots sorted by pid. A process is the same in
+// both when its pid and creation time match, so that a reused pid is reported
+// as an exited and a started process. An exited and a started process with the
+// same command line are reported as restarted.
+func diffSnapshots(before, after []*model.Process, top int) snapshotDiff {
+	type procKey struct {
+		pid        int32
+		createTime int64
+	}
+	previous := make(map[procKey]*model.Process, len(before))
+	for _, p := range before {
+		previous[procKey{p.Pid, p.CreateTime}] = p
+	}
+
+	var d snapshotDiff
+	var deltas []procDelta
+	var started []*model.Process
+	for _, p := range after {
+		k := procKey{p.Pid, p.CreateTime}
+		old, ok := previous[k]
+		if !ok {
+			started = append(started, p)
+			continue
+		}
+		delete(previous, k)
+		d.Changed = append(d.Changed, procChanges(old, p)...)
+		deltas = append(deltas, newProcDelta(old, p))
+	}
+
+	// The exited processes by command line, in pid order, to pair them with
+	// the started ones.
+	exitedByCmd := make(map[string][]*model.Process)
+	var exited []*model.Process
+	for _, p := range before {
+		if _, ok := previous[procKey{p.Pid, p.CreateTime}]; ok {
+			exited = append(exited, p)
+			if cmd := commandLine(p); cmd != "" {
+				exitedByCmd[cmd] = append(exitedByCmd[cmd], p)
+			}
+		}
+	}
+	restarted := make(map[int32]bool)
+	for _, p := range started {
+		cmd := commandLine(p)
+		if olds := exitedByCmd[cmd]; cmd != "" && len(olds) > 0 {
+			exitedByCmd[cmd] = olds[1:]
+			restarted[olds[0].Pid] = true
+			d.Restarted = append(d.Restarted, procRestart{OldPid: olds[0].Pid, NewPid: p.Pid, Command: cmd})
+			deltas = append(deltas, newProcDelta(olds[0], p))
+			continue
+		}
+		d.Started = append(d.Started, newDiffProcess(p))
+	}
+	for _, p := range exited {
+		if !restarted[p.Pid] {
+			d.Exited = append(d.Exited, newDiffProcess(p))
+		}
+	}
+
+	d.TopCPU = topDeltas(deltas, top, func(a procDelta) float64 { return float64(a.CPU) })
+	d.TopMemory = topDeltas(deltas, top, func(a procDelta) float64 { return float64(a.RSS) })
+	return d
+}
+
+func procChanges(before, after *model.Process) []procChange {
+	var changes []procChange
+	add := func(field, b, a string) {
+		if b != a {
+			changes = append(changes, procChange{Pid: after.Pid, Field: field, Before: b, After: a})
+		}
+	}
+	add("user", userName(before), userName(after))
+	add("cwd", workingDir(before), workingDir(after))
+	add("args", commandLine(before), commandLine(after))
+	return changes
+}
+
+func newProcDelta(before, after *model.Process) procDelta {
+	cpuBefore, rssBefore, _ := watchStats(before.Cpu, before.Memory, nil)
+	cpuAfter, rssAfter, _ := watchStats(after.Cpu, after.Memory, nil)
+	return procDelta{
+		Pid:     after.Pid,
+		Command: commandLine(after),
+		CPU:     cpuAfter - cpuBefore,
+		RSS:     int64(rssAfter) - int64(rssBefore),
+	}
+}
+
+// topDeltas returns the n deltas with the largest absolute value, ignoring the
+// processes whose value didn't change.
+func topDeltas(deltas []procDelta, n int, value func(procDelta) float64) []procDelta {
+	abs := func(d procDelta) float64 { return math.Abs(value(d)) }
+	var top []procDelta
+	for _, d := range deltas {
+		if value(d) != 0 {
+			top = append(top, d)
+		}
+	}
+	sort.SliceStable(top, func(i, j int) bool {
+		if abs(top[i]) != abs(top[j]) {
+			return abs(top[i]) > abs(top[j])
+		}
+		return top[i].Pid < top[j].Pid
+	})
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+func newDiffProcess(p *model.Process) diffProcess {
+	return diffProcess{Pid: p.Pid, User: userName(p), Command: commandLine(p)}
+}
+
+func userName(p *model.Process) string {
+	if p.User == nil {
+		return ""
+	}
+	return p.User.Name
+}
+
+func workingDir(p *model.Process) string {
+	if p.Command == nil {
+		return ""
+	}
+	return p.Command.Cwd
+}
+
+func commandLine(p *model.Process) string {
+	if p.Command == nil {
+		return ""
+	}
+	return strings.Join(p.Command.Args, " ")
+}
+
+func printDiff(out io.Writer, d snapshotDiff) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Started: %d, exited: %d, restarted: %d, changed: %d\n",
+		len(d.Started), len(d.Exited), len(d.Restarted), len(d.Changed))
+
+	printProcs := func(title string, procs []diffProcess) {
+		if len(procs) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n%s\nPID\tUSER\tCOMMAND\n", title)
+		for _, p := range procs {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", p.Pid, p.User, p.Command)
+		}
+	}
+	printProcs("STARTED", d.Started)
+	printProcs("EXITED", d.Exited)
+	if len(d.Restarted) > 0 {
+		fmt.Fprintf(w, "\nRESTARTED\nOLD PID\tNEW PID\tCOMMAND\n")
+		for _, r := range d.Restarted {
+			fmt.Fprintf(w, "%d\t%d\t%s\n", r.OldPid, r.NewPid, r.Command)
+		}
+	}
+	if len(d.Changed) > 0 {
+		fmt.Fprintf(w, "\nCHANGED\nPID\tFIELD\tBEFORE\tAFTER\n")
+		for _, c := range d.Changed {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", c.Pid, c.Field, c.Before, c.After)
+		}
+	}
+	if len(d.TopCPU) > 0 {
+		fmt.Fprintf(w, "\nTOP CPU DELTAS\nPID\tCPU%%\tCOMMAND\n")
+		for _, c := range d.TopCPU {
+			fmt.Fprintf(w, "%d\t%+.1f\t%s\n", c.Pid, c.CPU, c.Command)
+		}
+	}
+	if len(d.TopMemory) > 0 {
+		fmt.Fprintf(w, "\nTOP MEMORY DELTAS\nPID\tRSS\tCOMMAND\n")
+		for _, c := range d.TopMemory {
+			sign := "+"
+			rss := c.RSS
+			if rss < 0 {
+				sign, rss = "-", -rss
+			}
+			fmt.Fprintf(w, "%d\t%s%s\t%s\n", c.Pid, sign, formatBytes(float64(rss)), c.Command)
+		}
+	}
+	return w.Flush()
+}
+
+// exitDiff runs the diff subcommand with the command-line arguments and exits.
+// The config is loaded as the process check may run, whether the agent is
+// enabled or not.
+func exitDiff(args []string) {
+	agentConf, yamlConf, err := readConfigFiles()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	cfg, err := config.NewAgentConfig(agentConf, yamlConf)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing config: %s\n", err)
+		os.Exit(1)
+	}
+	initMetadataProviders()
+
+	sysInfo, err := checks.CollectSystemInfo(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	checks.Process.Init(cfg, sysInfo)
+	if err := runDiff(cfg, checks.Process, args, os.Stdout, os.Stderr); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func diffProc(pid int32, createTime int64, user, cwd string, cpu float32, rss uint64, args ...string) *model.Process {
+	return &model.Process{
+		Pid:        pid,
+		CreateTime: createTime,
+		User:       &model.ProcessUser{Name: user},
+		Command:    &model.Command{Args: args, Cwd: cwd},
+		Cpu:        &model.CPUStat{TotalPct: cpu},
+		Memory:     &model.MemoryStat{Rss: rss},
+	}
+}
+
+func testSnapshots() ([]*model.Process, []*model.Process) {
+	before := []*model.Process{
+		diffProc(1, 100, "root", "/", 1, 1000, "/sbin/init"),
+		diffProc(10, 200, "www", "/srv", 5, 5000, "nginx", "-g", "daemon off;"),
+		diffProc(20, 300, "redis", "/", 10, 8000, "redis-server"),
+		diffProc(30, 400, "root", "/", 0, 100, "sleep", "60"),
+		// The pid is reused by another process
+		diffProc(40, 500, "root", "/", 0, 100, "cron"),
+	}
+	after := []*model.Process{
+		diffProc(1, 100, "root", "/", 1, 1000, "/sbin/init"),
+		diffProc(10, 200, "nobody", "/var/www", 25, 3000, "nginx", "-g", "daemon off;"),
+		diffProc(21, 600, "redis", "/", 2, 2000, "redis-server"),
+		diffProc(40, 700, "app", "/app", 3, 100, "app", "--port", "80"),
+	}
+	return before, after
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before, after := testSnapshots()
+	d := diffSnapshots(before, after, 10)
+
+	assert.Equal(t, []diffProcess{{Pid: 40, User: "app", Command: "app --port 80"}}, d.Started)
+	assert.Equal(t, []diffProcess{
+		{Pid: 30, User: "root", Command: "sleep 60"},
+		{Pid: 40, User: "root", Command: "cron"},
+	}, d.Exited)
+	assert.Equal(t, []procRestart{{OldPid: 20, NewPid: 21, Command: "redis-server"}}, d.Restarted)
+	assert.Equal(t, []procChange{
+		{Pid: 10, Field: "user", Before: "www", After: "nobody"},
+		{Pid: 10, Field: "cwd", Before: "/srv", After: "/var/www"},
+	}, d.Changed)
+	assert.Equal(t, []procDelta{
+		{Pid: 10, Command: "nginx -g daemon off;", CPU: 20, RSS: -2000},
+		{Pid: 21, Command: "redis-server", CPU: -8, RSS: -6000},
+	}, d.TopCPU)
+	assert.Equal(t, []procDelta{
+		{Pid: 21, Command: "redis-server", CPU: -8, RSS: -6000},
+		{Pid: 10, Command: "nginx -g daemon off;", CPU: 20, RSS: -2000},
+	}, d.TopMemory)
+
+	d = diffSnapshots(before, after, 1)
+	assert.Len(t, d.TopCPU, 1)
+	assert.Equal(t, int32(10), d.TopCPU[0].Pid)
+}
+
+func writeSnapshot(t *testing.T, dir, name string, procs []*model.Process) string {
+	path := filepath.Join(dir, name)
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w, err := newResultWriter(f, "proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Snapshots are usually split in several messages
+	half := len(procs) / 2
+	err = w.Write([]model.MessageBody{
+		&model.CollectorProc{Processes: procs[:half]},
+		&model.CollectorProc{Processes: procs[half:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunDiffFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "process-agent-diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	before, after := testSnapshots()
+	beforePath := writeSnapshot(t, dir, "before.bin", before)
+	afterPath := writeSnapshot(t, dir, "after.bin", after)
+
+	var text, js bytes.Buffer
+	assert.NoError(t, runDiff(nil, nil, []string{beforePath, afterPath}, &text, ioutil.Discard))
+	lines := strings.Split(text.String(), "\n")
+	assert.Equal(t, "Started: 1, exited: 2, restarted: 1, changed: 2", lines[0])
+	assert.Contains(t, text.String(), "20       21       redis-server")
+	assert.Contains(t, text.String(), "10   +20.0  nginx -g daemon off;")
+	assert.Contains(t, text.String(), "21   -5.9K  redis-server")
+
+	assert.NoError(t, runDiff(nil, nil, []string{"-format", "json", beforePath, afterPath}, &js, ioutil.Discard))
+	var d snapshotDiff
+	assert.NoError(t, json.Unmarshal(js.Bytes(), &d))
+	assert.Equal(t, diffSnapshots(before, after, 10), d)
+
+	assert.Error(t, runDiff(nil, nil, []string{beforePath}, &text, ioutil.Discard))
+	assert.Error(t, runDiff(nil, nil, []string{beforePath, filepath.Join(dir, "missing.bin")}, &text, ioutil.Discard))
+	assert.Error(t, runDiff(nil, nil, []string{"-format", "xml", beforePath, afterPath}, &text, ioutil.Discard))
+}
+
+func TestSnapshotDeltaAndSampled(t *testing.T) {
+	before, _ := testSnapshots()
+	stripped := make([]*model.Process, 0, len(before))
+	for _, p := range before {
+		s := *p
+		s.Command, s.User = nil, nil
+		stripped = append(stripped, &s)
+	}
+
+	// The delta encoded processes get their metadata from the full message
+	s, err := snapshotProcesses([]model.MessageBody{
+		&model.CollectorProc{Processes: before},
+		&model.CollectorProc{Processes: stripped, Delta: true, Omitted: &model.OmittedProcesses{Count: 3}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, before, s.procs)
+	assert.Equal(t, int32(3), s.omitted)
+
+	// Without it, the snapshot is rejected
+	_, err = snapshotProcesses([]model.MessageBody{&model.CollectorProc{Processes: stripped, Delta: true}})
+	assert.Error(t, err)
+
+	// The sampled snapshots are reported
+	dir, err := ioutil.TempDir("", "process-agent-diff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sampled.bin")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := newResultWriter(f, "proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, w.Write([]model.MessageBody{&model.CollectorProc{Processes: before, Omitted: &model.OmittedProcesses{Count: 7}}}))
+	f.Close()
+	var out, errOut bytes.Buffer
+	assert.NoError(t, runDiff(nil, nil, []string{path, path}, &out, &errOut))
+	assert.Contains(t, errOut.String(), "7 processes were sampled out of the first snapshot")
+	assert.Contains(t, errOut.String(), "7 processes were sampled out of the second snapshot")
+}
+
+func TestRunDiffLive(t *testing.T) {
+	defer func(d time.Duration) { snapshotRunInterval = d }(snapshotRunInterval)
+	snapshotRunInterval = 0
+
+	var out bytes.Buffer
+	ch := &countingCheck{}
+	assert.NoError(t, runDiff(config.NewDefaultAgentConfig(), ch, []string{"-delay", "0"}, &out, ioutil.Discard))
+	// Each snapshot primes the check first
+	assert.Equal(t, []int32{0, 1, 0, 1}, ch.groupIDs)
+	assert.Equal(t, "Started: 0, exited: 0, restarted: 0, changed: 0\n", out.String())
+}
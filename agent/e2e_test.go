@@ -0,0 +1,136 @@
+// +build linux
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/forwarder/fakeintake"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const e2eTimeout = 10 * time.Second
+
+// writeFakeProcfs writes a minimal procfs with the host files read by the
+// checks and a few processes.
+func writeFakeProcfs(t *testing.T, dir string) {
+	files := map[string]string{
+		"stat":           "cpu  100 0 50 1000 0 0 0 0 0 0\nctxt 1000\nbtime 1519898400\nprocesses 100\n",
+		"meminfo":        "MemTotal: 2048000 kB\nMemFree: 1024000 kB\nMemAvailable: 1536000 kB\nBuffers: 1000 kB\nCached: 2000 kB\n",
+		"cpuinfo":        "processor\t: 0\nvendor_id\t: GenuineIntel\ncpu family\t: 6\nmodel\t\t: 79\nmodel name\t: Intel(R) Xeon(R)\ncpu MHz\t\t: 2300.000\ncache size\t: 46080 KB\nphysical id\t: 0\ncore id\t\t: 0\ncpu cores\t: 1\n\n",
+		"sys/fs/file-nr": "1024\t0\t65536\n",
+	}
+	procs := []struct {
+		pid     int
+		name    string
+		cmdline string
+	}{
+		{1, "init", "/sbin/init"},
+		{42, "redis-server", "/usr/bin/redis-server\x00*:6379"},
+	}
+	for _, p := range procs {
+		files[fmt.Sprintf("%d/cmdline", p.pid)] = p.cmdline
+		files[fmt.Sprintf("%d/stat", p.pid)] = fmt.Sprintf("%d (%s) S 1 %d %d 0 -1 4194560 1 2 3 4 10 5 0 0 20 0 2 0 100 4096000 250\n", p.pid, p.name, p.pid, p.pid)
+		files[fmt.Sprintf("%d/status", p.pid)] = fmt.Sprintf("Name:\t%s\nState:\tS (sleeping)\nUid:\t0\t0\t0\t0\nGid:\t0\t0\t0\t0\nThreads:\t2\nvoluntary_ctxt_switches:\t10\nnonvoluntary_ctxt_switches:\t1\n", p.name)
+		files[fmt.Sprintf("%d/statm", p.pid)] = "1000 250 100 10 0 200 0\n"
+		files[fmt.Sprintf("%d/io", p.pid)] = "rchar: 100\nwchar: 200\nsyscr: 1\nsyscw: 2\nread_bytes: 4096\nwrite_bytes: 8192\n"
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func isType(t model.MessageType) func(fakeintake.Payload) bool {
+	return func(p fakeintake.Payload) bool { return p.Message.Header.Type == t }
+}
+
+func TestCollectorEndToEnd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "e2e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFakeProcfs(t, filepath.Join(dir, "proc"))
+	os.Setenv("HOST_PROC", filepath.Join(dir, "proc"))
+	defer os.Unsetenv("HOST_PROC")
+	// Don't pick up the containers of the host running the tests.
+	os.Setenv("HOST_SYS", filepath.Join(dir, "sys"))
+	defer os.Unsetenv("HOST_SYS")
+
+	intake := fakeintake.New()
+	defer intake.Close()
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoint = intake.URL()
+	cfg.APIKey = "apikey"
+	cfg.HostName = "e2e-host"
+	cfg.EnabledChecks = []string{"process", "rtprocess"}
+	cfg.CheckIntervals["process"] = 100 * time.Millisecond
+
+	l, err := NewCollector(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exit := make(chan bool)
+	go l.run(exit)
+	defer close(exit)
+
+	// The first run only primes the caches, the second one reports the processes.
+	idx, err := intake.WaitFor(0, e2eTimeout, isType(model.TypeCollectorProc))
+	if !assert.NoError(t, err) {
+		return
+	}
+	p := intake.Payloads()[idx]
+	assert.Equal(t, "/api/v1/collector", p.Endpoint)
+	assert.Equal(t, "apikey", p.Header.Get("X-Dd-APIKey"))
+	assert.Equal(t, model.MessageVersion(model.MessageV3), p.Message.Header.Version)
+	msg := p.Message.Body.(*model.CollectorProc)
+	assert.Equal(t, "e2e-host", msg.HostName)
+	assert.Equal(t, int64(1), msg.Info.Sequence)
+	if assert.Len(t, msg.Processes, 2) {
+		pids := []int32{msg.Processes[0].Pid, msg.Processes[1].Pid}
+		assert.Contains(t, pids, int32(1))
+		assert.Contains(t, pids, int32(42))
+	}
+
+	// Clients connecting to the intake turn real-time mode on at the requested interval.
+	intake.ReplyWith(&model.CollectorStatus{ActiveClients: 1, Interval: 1})
+	idx, err = intake.WaitFor(idx+1, e2eTimeout, isType(model.TypeCollectorRealTime))
+	if !assert.NoError(t, err) {
+		return
+	}
+	rt := intake.Payloads()[idx].Message.Body.(*model.CollectorRealTime)
+	assert.Equal(t, "e2e-host", rt.HostName)
+	assert.Len(t, rt.Stats, 2)
+
+	// Once they are gone real-time mode is turned off.
+	intake.ReplyWith(&model.CollectorStatus{ActiveClients: 0, Interval: 1})
+	off, err := intake.WaitFor(idx+1, e2eTimeout, isType(model.TypeCollectorProc))
+	if !assert.NoError(t, err) {
+		return
+	}
+	off, err = intake.WaitFor(off+1, e2eTimeout, isType(model.TypeCollectorProc))
+	if !assert.NoError(t, err) {
+		return
+	}
+	// Leave time for more than a real-time interval.
+	time.Sleep(1500 * time.Millisecond)
+	for _, p := range intake.Payloads()[off:] {
+		assert.NotEqual(t, model.MessageType(model.TypeCollectorRealTime), p.Message.Header.Type)
+	}
+	assert.Len(t, intake.Errors(), 0)
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// checkFormats are the output formats of --check. json and jsonpb marshal the
+// messages with respectively the Go and the protobuf field names, yaml is the
+// jsonpb representation as YAML documents, proto writes V3 protobuf messages
+// prefixed with their big-endian length (as the binary file sink does, so the
+// decode subcommand reads them back), table prints the tables of the decode
+// subcommand and csv a row per process and container.
+var checkFormats = []string{"json", "jsonpb", "proto", "yaml", "table", "csv"}
+
+var csvHeader = []string{"kind", "id", "user", "container", "cpu_pct", "rss", "io_bps", "open_fds", "command"}
+
+// resultWriter writes the messages of the successive --check runs in a format.
+type resultWriter struct {
+	out    io.Writer
+	format string
+	csv    *csv.Writer
+}
+
+func newResultWriter(out io.Writer, format string) (*resultWriter, error) {
+	for _, f := range checkFormats {
+		if f == format {
+			return &resultWriter{out: out, format: format}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid format '%s', choose from: %v", format, checkFormats)
+}
+
+// Write writes the messages of a check run.
+func (r *resultWriter) Write(msgs []model.MessageBody) error {
+	if r.format == "csv" {
+		return r.writeCSV(msgs)
+	}
+	for _, m := range msgs {
+		if err := r.writeMessage(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *resultWriter) writeMessage(m model.MessageBody) error {
+	switch r.format {
+	case "json":
+		b, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		_, err = fmt.Fprintln(r.out, string(b))
+		return err
+	case "jsonpb":
+		marshaler := jsonpb.Marshaler{Indent: "  "}
+		if err := marshaler.Marshal(r.out, m); err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		_, err := fmt.Fprintln(r.out)
+		return err
+	case "yaml":
+		var buf bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&buf, m); err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		// Go through JSON to keep the protobuf field names.
+		var v interface{}
+		if err := yaml.Unmarshal(buf.Bytes(), &v); err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		_, err = fmt.Fprintf(r.out, "---\n%s", b)
+		return err
+	case "proto":
+		msg, err := model.NewMessage(model.MessageV3, model.MessageEncodingProtobuf, m)
+		if err != nil {
+			return err
+		}
+		data, err := model.EncodeMessage(msg)
+		if err != nil {
+			return fmt.Errorf("marshal error: %s", err)
+		}
+		if err := binary.Write(r.out, binary.BigEndian, uint32(len(data))); err != nil {
+			return err
+		}
+		_, err = r.out.Write(data)
+		return err
+	case "table":
+		msg, err := model.NewMessage(model.MessageV3, model.MessageEncodingProtobuf, m)
+		if err != nil {
+			return err
+		}
+		return printMessageTable(r.out, msg)
+	}
+	return nil
+}
+
+func (r *resultWriter) writeCSV(msgs []model.MessageBody) error {
+	if r.csv == nil {
+		r.csv = csv.NewWriter(r.out)
+		if err := r.csv.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	for _, row := range watchRows(msgs) {
+		r.csv.Write([]string{
+			"process",
+			strconv.Itoa(int(row.pid)),
+			row.user,
+			row.container,
+			formatFloat(row.cpu),
+			strconv.FormatUint(row.rss, 10),
+			formatFloat(row.io),
+			strconv.Itoa(int(row.fds)),
+			row.command,
+		})
+	}
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			r.writeContainersCSV(msg.Containers)
+		case *model.CollectorContainer:
+			r.writeContainersCSV(msg.Containers)
+		case *model.CollectorRealTime:
+			r.writeContainerStatsCSV(msg.ContainerStats)
+		case *model.CollectorContainerRealTime:
+			r.writeContainerStatsCSV(msg.Stats)
+		}
+	}
+	r.csv.Flush()
+	return r.csv.Error()
+}
+
+func (r *resultWriter) writeContainersCSV(ctrs []*model.Container) {
+	for _, c := range ctrs {
+		r.csv.Write([]string{"container", c.Id, "", c.Id, formatFloat(c.TotalPct),
+			strconv.FormatUint(c.MemRss, 10), formatFloat(c.Rbps + c.Wbps), "", c.Image})
+	}
+}
+
+func (r *resultWriter) writeContainerStatsCSV(stats []*model.ContainerStat) {
+	for _, c := range stats {
+		r.csv.Write([]string{"container", c.Id, "", c.Id, formatFloat(c.TotalPct),
+			strconv.FormatUint(c.MemRss, 10), formatFloat(c.Rbps + c.Wbps), "", ""})
+	}
+}
+
+func formatFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
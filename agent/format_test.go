@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// countingCheck is a check returning testWatchMessages and counting its runs.
+type countingCheck struct {
+	groupIDs []int32
+}
+
+func (c *countingCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {}
+func (c *countingCheck) Name() string                                         { return "counting" }
+func (c *countingCheck) Endpoint() string                                     { return "/api/v1/collector" }
+func (c *countingCheck) RealTime() bool                                       { return false }
+
+func (c *countingCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	c.groupIDs = append(c.groupIDs, groupID)
+	return testWatchMessages(), nil
+}
+
+func writeResults(t *testing.T, format string, msgs []model.MessageBody) []byte {
+	var out bytes.Buffer
+	w, err := newResultWriter(&out, format)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, w.Write(msgs))
+	return out.Bytes()
+}
+
+func TestResultFormats(t *testing.T) {
+	msgs := []model.MessageBody{testProcMessage()}
+
+	var decoded struct{ HostName string }
+	assert.NoError(t, json.Unmarshal(writeResults(t, "json", msgs), &decoded))
+	assert.Equal(t, "my-host", decoded.HostName)
+
+	// jsonpb keeps the protobuf field names
+	jsonpb := writeResults(t, "jsonpb", msgs)
+	assert.Contains(t, string(jsonpb), `"hostName": "my-host"`)
+	assert.Contains(t, string(jsonpb), `"containerId": "abc"`)
+
+	var doc struct {
+		HostName  string `yaml:"hostName"`
+		Processes []struct {
+			Pid int32 `yaml:"pid"`
+		} `yaml:"processes"`
+	}
+	assert.NoError(t, yaml.Unmarshal(writeResults(t, "yaml", msgs), &doc))
+	assert.Equal(t, "my-host", doc.HostName)
+	assert.Len(t, doc.Processes, 3)
+
+	// The proto output can be read by the decode subcommand
+	decodedMsgs, err := decodeMessages(writeResults(t, "proto", append(msgs, msgs...)))
+	assert.NoError(t, err)
+	assert.Len(t, decodedMsgs, 2)
+	assert.Equal(t, model.MessageVersion(model.MessageV3), decodedMsgs[0].Header.Version)
+	assert.Equal(t, "my-host", decodedMsgs[1].Body.(*model.CollectorProc).HostName)
+
+	table := string(writeResults(t, "table", msgs))
+	assert.True(t, strings.HasPrefix(table, "CollectorProc (type=12 version=3"))
+	assert.Contains(t, table, "redis-server *:6379")
+
+	_, err = newResultWriter(&bytes.Buffer{}, "xml")
+	assert.Error(t, err)
+}
+
+func TestResultCSV(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newResultWriter(&out, "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, w.Write(testWatchMessages()))
+	assert.NoError(t, w.Write([]model.MessageBody{testProcMessage()}))
+
+	records, err := csv.NewReader(&out).ReadAll()
+	assert.NoError(t, err)
+	// The header is only written once
+	assert.Equal(t, csvHeader, records[0])
+	assert.Len(t, records, 1+3+3+1)
+	assert.Equal(t, []string{"process", "42", "redis", "0123456789abcdef", "20", "3145728", "2048", "10", "redis-server *:6379"}, records[2])
+	assert.Equal(t, []string{"container", "abc", "", "abc", "0", "0", "0", "", "redis:4"}, records[7])
+}
+
+func TestRunCheckResults(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newResultWriter(&out, "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := &countingCheck{}
+	assert.NoError(t, runCheckResults(config.NewDefaultAgentConfig(), ch, w, 3, 0))
+
+	// A priming run then the 3 printed runs
+	assert.Equal(t, []int32{0, 1, 2, 3}, ch.groupIDs)
+	records, err := csv.NewReader(&out).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 1+3*3)
+}
@@ -30,6 +30,8 @@ var (
 	infoProcCount       int
 	infoContainerCount  int
 	infoQueueSize       int
+	infoRemoteSettings  map[string]string
+	infoConfig          *config.AgentConfig
 )
 
 const (
@@ -46,7 +48,9 @@ const (
   Docker socket: {{.Status.DockerSocket}}{{end}}
   Number of processes: {{.Status.ProcessCount}}
   Number of containers: {{.Status.ContainerCount}}
-  Queue length: {{.Status.QueueSize}}
+  Queue length: {{.Status.QueueSize}}{{if .Status.RemoteSettings}}
+  Remote settings:{{range $k, $v := .Status.RemoteSettings}}
+    {{$k}}: {{$v}}{{end}}{{end}}
 
   Logs: {{.Status.Config.LogFile}}{{if .Status.ProxyURL}}
   HttpProxy: {{.Status.ProxyURL}}{{end}}{{if ne .Status.ContainerID ""}}
@@ -148,6 +152,35 @@ func publishQueueSize() interface{} {
 	return infoQueueSize
 }
 
+func updateRemoteSettingsInfo(entries map[string]string) {
+	infoMutex.Lock()
+	defer infoMutex.Unlock()
+	infoRemoteSettings = entries
+}
+
+func publishRemoteSettings() interface{} {
+	infoMutex.RLock()
+	defer infoMutex.RUnlock()
+	return infoRemoteSettings
+}
+
+func updateInfoConfig(cfg *config.AgentConfig) {
+	infoMutex.Lock()
+	defer infoMutex.Unlock()
+	infoConfig = cfg
+}
+
+// publishConfig exposes the config currently in use, reloads included.
+// Secrets are never exposed, --info reads the config from expvar.
+func publishConfig() interface{} {
+	infoMutex.RLock()
+	defer infoMutex.RUnlock()
+	if infoConfig == nil {
+		return nil
+	}
+	return infoConfig.Redacted()
+}
+
 func publishContainerID() interface{} {
 	cgroupFile := "/proc/self/cgroup"
 	if !util.PathExists(cgroupFile) {
@@ -186,10 +219,6 @@ func getProgramBanner(version string) (string, string) {
 	return program, banner
 }
 
-type infoString string
-
-func (s infoString) String() string { return string(s) }
-
 type infoVersion struct {
 	Version   string
 	GitCommit string
@@ -212,6 +241,7 @@ type StatusInfo struct {
 	QueueSize       int                    `json:"queue_size"`
 	ContainerID     string                 `json:"container_id"`
 	ProxyURL        string                 `json:"proxy_url"`
+	RemoteSettings  map[string]string      `json:"remote_settings"`
 }
 
 func initInfo(conf *config.AgentConfig) error {
@@ -225,6 +255,7 @@ func initInfo(conf *config.AgentConfig) error {
 			return fmt.Sprintf("%02.1f", v*100)
 		},
 	}
+	updateInfoConfig(conf)
 	infoOnce.Do(func() {
 		expvar.NewInt("pid").Set(int64(os.Getpid()))
 		expvar.Publish("uptime", expvar.Func(publishUptime))
@@ -235,13 +266,8 @@ func initInfo(conf *config.AgentConfig) error {
 		expvar.Publish("container_count", expvar.Func(publishContainerCount))
 		expvar.Publish("queue_size", expvar.Func(publishQueueSize))
 		expvar.Publish("container_id", expvar.Func(publishContainerID))
-		c := *conf
-		var buf []byte
-		buf, err = json.Marshal(&c)
-		if err != nil {
-			return
-		}
-		expvar.Publish("config", infoString(string(buf)))
+		expvar.Publish("remote_settings", expvar.Func(publishRemoteSettings))
+		expvar.Publish("config", expvar.Func(publishConfig))
 
 		infoTmpl, err = template.New("info").Funcs(funcMap).Parse(infoTmplSrc)
 		if err != nil {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"expvar"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -137,3 +138,20 @@ func TestError(t *testing.T) {
 
 	assert.Equal(errInfo, info)
 }
+
+func TestInfoConfigReload(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewDefaultAgentConfig()
+	conf.HostName = "before-reload"
+	conf.APIKey = "secret_api_key"
+	assert.NoError(initInfo(conf))
+	assert.Contains(expvar.Get("config").String(), "before-reload")
+
+	reloaded := config.NewDefaultAgentConfig()
+	reloaded.HostName = "after-reload"
+	updateInfoConfig(reloaded)
+	published := expvar.Get("config").String()
+	assert.Contains(published, "after-reload")
+	assert.NotContains(published, "before-reload")
+	assert.NotContains(published, "secret_api_key")
+}
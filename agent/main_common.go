@@ -2,11 +2,15 @@ package main
 
 import (
 	"bytes"
-	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"regexp"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	log "github.com/cihub/seelog"
@@ -27,6 +31,36 @@ var opts struct {
 	version      bool
 	check        string
 	info         bool
+
+	// Continuous display of the --check results
+	watch           bool
+	sortBy          string
+	filterUser      string
+	filterContainer string
+	filterCmd       string
+
+	// Output of the --check results
+	format   string
+	count    int
+	interval time.Duration
+	output   string
+
+	// Show how the process rules filter the processes instead of the results
+	explainRules bool
+}
+
+// registerCheckFlags registers the flags tuning how --check displays its results.
+func registerCheckFlags() {
+	flag.BoolVar(&opts.watch, "watch", false, "Refresh the results of the process or rtprocess --check continuously")
+	flag.StringVar(&opts.sortBy, "sort", "cpu", "Sort the --watch results by cpu, mem, io or fds")
+	flag.StringVar(&opts.filterUser, "filter-user", "", "Only --watch the processes of the given user, with the process check")
+	flag.StringVar(&opts.filterContainer, "filter-container", "", "Only --watch the processes of the containers with the given ID prefix")
+	flag.StringVar(&opts.filterCmd, "filter-cmd", "", "Only --watch the processes with a command line matching the given regex, with the process check")
+	flag.StringVar(&opts.format, "format", "json", "Format of the --check results: json, jsonpb, proto, yaml, table or csv")
+	flag.IntVar(&opts.count, "count", 1, "Number of times to run the --check")
+	flag.DurationVar(&opts.interval, "interval", time.Second, "Interval between the --check runs")
+	flag.StringVar(&opts.output, "output", "", "Write the --check results to the given file instead of stdout")
+	flag.BoolVar(&opts.explainRules, "explain-rules", false, "Show whether each process is collected by the process or rtprocess --check, and the rule deciding it, instead of the results")
 }
 
 // version info sourced from build flags
@@ -82,6 +116,21 @@ func runAgent(exit chan bool) {
 		os.Exit(0)
 	}
 
+	// Subcommands are given after the flags and run instead of the agent.
+	if flag.NArg() > 0 {
+		switch flag.Arg(0) {
+		case "decode":
+			exitDecode(flag.Args()[1:])
+		case "configcheck":
+			exitConfigCheck()
+		case "diff":
+			exitDiff(flag.Args()[1:])
+		default:
+			fmt.Printf("unknown command '%s', choose from: configcheck, decode, diff\n", flag.Arg(0))
+			os.Exit(1)
+		}
+	}
+
 	if opts.check == "" && !opts.info && opts.pidfilePath != "" {
 		err := pidfile.WritePID(opts.pidfilePath)
 		if err != nil {
@@ -96,21 +145,12 @@ func runAgent(exit chan bool) {
 		}()
 	}
 
-	agentConf, err := config.NewIfExists(opts.ddConfigPath)
+	agentConf, yamlConf, err := readConfigFiles()
 	if err != nil {
-		log.Criticalf("Error reading dd-agent config: %s", err)
+		log.Critical(err)
 		os.Exit(1)
 	}
 
-	yamlConf, err := config.NewYamlIfExists(opts.configPath)
-	if err != nil {
-		log.Criticalf("Error reading datadog.yaml: %s", err)
-		os.Exit(1)
-	}
-	if yamlConf != nil {
-		config.SetupDDAgentConfig(opts.configPath)
-	}
-
 	if err := tagger.Init(); err == nil {
 		defer tagger.Stop()
 	} else {
@@ -192,12 +232,31 @@ func runAgent(exit chan bool) {
 		os.Exit(1)
 		return
 	}
+	http.HandleFunc("/config/reload", cl.handleReload)
 	cl.run(exit)
 	for range exit {
 
 	}
 }
 
+// readConfigFiles reads the dd-agent config and datadog.yaml given on the
+// command line, each of them being nil when the file doesn't exist.
+func readConfigFiles() (*config.File, *config.YamlAgentConfig, error) {
+	agentConf, err := config.NewIfExists(opts.ddConfigPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading dd-agent config: %s", err)
+	}
+
+	yamlConf, err := config.NewYamlIfExists(opts.configPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading datadog.yaml: %s", err)
+	}
+	if yamlConf != nil {
+		config.SetupDDAgentConfig(opts.configPath)
+	}
+	return agentConf, yamlConf, nil
+}
+
 func debugCheckResults(cfg *config.AgentConfig, check string) error {
 	sysInfo, err := checks.CollectSystemInfo(cfg)
 	if err != nil {
@@ -207,7 +266,16 @@ func debugCheckResults(cfg *config.AgentConfig, check string) error {
 	names := make([]string, 0, len(checks.All))
 	for _, ch := range checks.All {
 		if ch.Name() == check {
+			if opts.explainRules {
+				if ch != checks.Process && ch != checks.RTProcess {
+					return fmt.Errorf("-explain-rules only applies to the process and rtprocess checks")
+				}
+				return printRuleResults(cfg, os.Stdout, opts.interval)
+			}
 			ch.Init(cfg, sysInfo)
+			if opts.watch {
+				return watchResults(cfg, ch)
+			}
 			return printResults(cfg, ch)
 		}
 		names = append(names, ch.Name())
@@ -215,29 +283,95 @@ func debugCheckResults(cfg *config.AgentConfig, check string) error {
 	return fmt.Errorf("invalid check '%s', choose from: %v", check, names)
 }
 
-func printResults(cfg *config.AgentConfig, ch checks.Check) error {
-	// Run the check once to prime the cache.
-	_, err := ch.Run(cfg, 0)
+// printRuleResults writes whether each process is collected, and the rule or the
+// reason deciding it.
+func printRuleResults(cfg *config.AgentConfig, out io.Writer, interval time.Duration) error {
+	results, err := checks.ExplainProcessRules(cfg, interval)
 	if err != nil {
 		return fmt.Errorf("collection error: %s", err)
 	}
-	time.Sleep(1 * time.Second)
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "PID\tCOLLECTED\tREASON\tCOMMAND\n")
+	for _, r := range results {
+		collected := "no"
+		if r.Collected {
+			collected = "yes"
+		}
+		reason := r.Reason
+		if reason == "" {
+			reason = "-"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", r.Pid, collected, reason, strings.Join(r.Cmdline, " "))
+	}
+	return w.Flush()
+}
 
-	fmt.Printf("-----------------------------\n\n")
-	fmt.Printf("\nResults for check %s\n", ch.Name())
-	fmt.Printf("-----------------------------\n\n")
+func watchResults(cfg *config.AgentConfig, ch checks.Check) error {
+	wopts := watchOptions{
+		sortBy:    opts.sortBy,
+		user:      opts.filterUser,
+		container: opts.filterContainer,
+	}
+	if opts.filterCmd != "" {
+		r, err := regexp.Compile(opts.filterCmd)
+		if err != nil {
+			return fmt.Errorf("invalid -filter-cmd: %s", err)
+		}
+		wopts.cmd = r
+	}
+	return watchCheck(cfg, ch, wopts, os.Stdout, os.Stdin, nil)
+}
 
-	msgs, err := ch.Run(cfg, 1)
+func printResults(cfg *config.AgentConfig, ch checks.Check) error {
+	out := io.Writer(os.Stdout)
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	w, err := newResultWriter(out, opts.format)
+	if err != nil {
+		return err
+	}
+	if opts.count < 1 {
+		return fmt.Errorf("invalid -count %d, must be at least 1", opts.count)
+	}
+	return runCheckResults(cfg, ch, w, opts.count, opts.interval)
+}
+
+// runCheckResults runs the check count times, interval apart, and writes the
+// results of each run.
+func runCheckResults(cfg *config.AgentConfig, ch checks.Check, w *resultWriter, count int, interval time.Duration) error {
+	// Run the check once to prime the cache.
+	_, err := ch.Run(cfg, 0)
 	if err != nil {
 		return fmt.Errorf("collection error: %s", err)
 	}
 
-	for _, m := range msgs {
-		b, err := json.MarshalIndent(m, "", "  ")
+	for i := 1; i <= count; i++ {
+		time.Sleep(interval)
+
+		// Only print the banner in the formats meant to be read, not parsed.
+		if w.format == "json" || w.format == "table" {
+			fmt.Fprintf(w.out, "-----------------------------\n\n")
+			if count > 1 {
+				fmt.Fprintf(w.out, "\nResults for check %s (run %d/%d)\n", ch.Name(), i, count)
+			} else {
+				fmt.Fprintf(w.out, "\nResults for check %s\n", ch.Name())
+			}
+			fmt.Fprintf(w.out, "-----------------------------\n\n")
+		}
+
+		msgs, err := ch.Run(cfg, int32(i))
 		if err != nil {
-			return fmt.Errorf("marshal error: %s", err)
+			return fmt.Errorf("collection error: %s", err)
+		}
+		if err := w.Write(msgs); err != nil {
+			return err
 		}
-		fmt.Println(string(b))
 	}
 	return nil
 }
@@ -18,8 +18,8 @@ func initMetadataProviders() {
 	}
 }
 
-// Handles signals - tells us whether we should exit.
-func handleSignals(exit chan bool) {
+// Handles signals - tells us whether we should exit or reload the configuration.
+func handleSignals(exit chan bool, reload func()) {
 	sigIn := make(chan os.Signal, 100)
 	signal.Notify(sigIn)
 	// unix only in all likelihood;  but we don't care.
@@ -28,6 +28,9 @@ func handleSignals(exit chan bool) {
 		case syscall.SIGINT, syscall.SIGTERM:
 			log.Criticalf("Caught signal '%s'; terminating.", sig)
 			close(exit)
+		case syscall.SIGHUP:
+			log.Infof("Caught signal '%s'; reloading the configuration.", sig)
+			reload()
 		case syscall.SIGCHLD:
 			// Running docker.GetDockerStat() spins up / kills a new process
 			continue
@@ -14,8 +14,8 @@ func initMetadataProviders() {
 	// No-op
 }
 
-// Handles signals - tells us whether we should exit.
-func handleSignals(exit chan bool) {
+// Handles signals - tells us whether we should exit or reload the configuration.
+func handleSignals(exit chan bool, reload func()) {
 	sigIn := make(chan os.Signal, 100)
 	signal.Notify(sigIn)
 	// unix only in all likelihood;  but we don't care.
@@ -24,6 +24,9 @@ func handleSignals(exit chan bool) {
 		case syscall.SIGINT, syscall.SIGTERM:
 			log.Criticalf("Caught signal '%s'; terminating.", sig)
 			close(exit)
+		case syscall.SIGHUP:
+			log.Infof("Caught signal '%s'; reloading the configuration.", sig)
+			reload()
 		default:
 			log.Warnf("Caught signal %s; continuing/ignoring.", sig)
 		}
@@ -119,6 +119,7 @@ func main() {
 	flag.BoolVar(&opts.info, "info", false, "Show info about running process agent and exit")
 	flag.BoolVar(&opts.version, "version", false, "Print the version and exit")
 	flag.StringVar(&opts.check, "check", "", "Run a specific check and print the results. Choose from: process, connections, realtime")
+	registerCheckFlags()
 
 	// windows-specific options for installing the service, uninstalling the service, etc.
 	flag.BoolVar(&winopts.installService, "install-service", false, "Install the trace agent to the Service Control Manager")
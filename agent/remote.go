@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// Bounds of the settings pushed by the intake.
+const (
+	minRemoteInterval          = 2 * time.Second
+	maxRemoteInterval          = 24 * time.Hour
+	maxRemoteBlacklistPatterns = 100
+)
+
+// remoteSettings are the overrides of the local configuration pushed by the
+// intake, see model.RemoteSettings, once validated and bounded.
+type remoteSettings struct {
+	checkIntervals map[string]time.Duration
+	checks         map[string]bool
+	procLimit      int
+	blacklist      []*regexp.Regexp
+}
+
+// newRemoteSettings validates the settings pushed by the intake. The settings of
+// unknown checks, the intervals of the real-time checks which are driven by
+// CollectorStatus.Interval and the invalid patterns are ignored, the intervals
+// and the process limit are bounded.
+func newRemoteSettings(s *model.RemoteSettings, known []checks.Check) remoteSettings {
+	r := remoteSettings{
+		checkIntervals: make(map[string]time.Duration),
+		checks:         make(map[string]bool),
+	}
+	byName := make(map[string]checks.Check, len(known))
+	for _, c := range known {
+		byName[c.Name()] = c
+	}
+
+	for name, seconds := range s.CheckIntervals {
+		c, ok := byName[name]
+		if !ok || c.RealTime() {
+			log.Warnf("Ignoring remote interval of check '%s'", name)
+			continue
+		}
+		r.checkIntervals[name] = boundDuration(name+" interval", time.Duration(seconds)*time.Second, minRemoteInterval, maxRemoteInterval)
+	}
+	for name, enabled := range s.Checks {
+		if _, ok := byName[name]; !ok {
+			log.Warnf("Ignoring remote toggle of unknown check '%s'", name)
+			continue
+		}
+		r.checks[name] = enabled
+	}
+	if s.ProcLimit > 0 {
+		r.procLimit = int(s.ProcLimit)
+		if r.procLimit > config.MaxProcLimit {
+			log.Warnf("Remote process limit %d exceeds the maximum, using %d", r.procLimit, config.MaxProcLimit)
+			r.procLimit = config.MaxProcLimit
+		}
+	}
+	for i, p := range s.BlacklistPatterns {
+		if i == maxRemoteBlacklistPatterns {
+			log.Warnf("Ignoring %d remote blacklist patterns over the maximum of %d", len(s.BlacklistPatterns)-i, maxRemoteBlacklistPatterns)
+			break
+		}
+		re, err := regexp.Compile(p)
+		if err != nil {
+			log.Warnf("Ignoring invalid remote blacklist pattern '%s': %s", p, err)
+			continue
+		}
+		r.blacklist = append(r.blacklist, re)
+	}
+	return r
+}
+
+func boundDuration(name string, d, min, max time.Duration) time.Duration {
+	if d < min {
+		log.Warnf("Remote %s %s is below the minimum, using %s", name, d, min)
+		return min
+	}
+	if d > max {
+		log.Warnf("Remote %s %s exceeds the maximum, using %s", name, d, max)
+		return max
+	}
+	return d
+}
+
+// apply returns a copy of the local configuration with the remote settings.
+func (r remoteSettings) apply(local *config.AgentConfig) *config.AgentConfig {
+	cfg := *local
+
+	cfg.CheckIntervals = make(map[string]time.Duration, len(local.CheckIntervals))
+	for name, d := range local.CheckIntervals {
+		cfg.CheckIntervals[name] = d
+	}
+	for name, d := range r.checkIntervals {
+		cfg.CheckIntervals[name] = d
+	}
+
+	// The intake can only disable the checks enabled locally, never enable others.
+	if len(r.checks) > 0 {
+		enabled := make([]string, 0, len(local.EnabledChecks))
+		for _, name := range local.EnabledChecks {
+			if on, ok := r.checks[name]; !ok || on {
+				enabled = append(enabled, name)
+			}
+		}
+		cfg.EnabledChecks = enabled
+	}
+
+	if r.procLimit > 0 {
+		cfg.ProcLimit = r.procLimit
+	}
+	if len(r.blacklist) > 0 {
+		cfg.Blacklist = append(local.Blacklist[:len(local.Blacklist):len(local.Blacklist)], r.blacklist...)
+	}
+	return &cfg
+}
+
+// entries returns the settings formatted by key, for the logs and the status.
+// The checks enabled by the intake but disabled in the local configuration
+// are reported as ignored, as apply never enables them.
+func (r remoteSettings) entries(local *config.AgentConfig) map[string]string {
+	entries := make(map[string]string)
+	for name, d := range r.checkIntervals {
+		entries["interval."+name] = d.String()
+	}
+	for name, on := range r.checks {
+		if on && !util.StringInSlice(local.EnabledChecks, name) {
+			entries["check."+name] = "ignored (disabled locally)"
+		} else if on {
+			entries["check."+name] = "enabled"
+		} else {
+			entries["check."+name] = "disabled"
+		}
+	}
+	if r.procLimit > 0 {
+		entries["proc_limit"] = fmt.Sprint(r.procLimit)
+	}
+	if len(r.blacklist) > 0 {
+		patterns := make([]string, 0, len(r.blacklist))
+		for _, re := range r.blacklist {
+			patterns = append(patterns, re.String())
+		}
+		entries["blacklist_patterns"] = "[" + strings.Join(patterns, ", ") + "]"
+	}
+	return entries
+}
+
+// logRemoteChanges logs every setting changed between two remote settings.
+func logRemoteChanges(before, after remoteSettings, local *config.AgentConfig) {
+	b, a := before.entries(local), after.entries(local)
+	keys := make(map[string]bool, len(a)+len(b))
+	for k := range b {
+		keys[k] = true
+	}
+	for k := range a {
+		keys[k] = true
+	}
+	for _, k := range sortedSettings(keys) {
+		switch old, new := b[k], a[k]; {
+		case old == new:
+		case old == "":
+			log.Infof("Remote setting %s set to %s", k, new)
+		case new == "":
+			log.Infof("Remote setting %s reverted to the local configuration", k)
+		default:
+			log.Infof("Remote setting %s changed from %s to %s", k, old, new)
+		}
+	}
+}
+
+// updateRemoteSettings applies the settings pushed by the intake on top of the
+// local configuration, when they changed since the previous response.
+func (l *Collector) updateRemoteSettings(s *model.RemoteSettings) {
+	l.reloadMu.Lock()
+	defer l.reloadMu.Unlock()
+	if l.lastRemote != nil && reflect.DeepEqual(*s, *l.lastRemote) {
+		return
+	}
+	l.lastRemote = s
+
+	remote := newRemoteSettings(s, l.checks)
+	logRemoteChanges(l.remote, remote, l.localCfg)
+	l.remote = remote
+	updateRemoteSettingsInfo(remote.entries(l.localCfg))
+
+	cfg := remote.apply(l.localCfg)
+	l.cfg.Store(cfg)
+	updateInfoConfig(cfg)
+	l.runnersMu.Lock()
+	defer l.runnersMu.Unlock()
+	if l.exit != nil {
+		l.applyChecks(cfg)
+	}
+}
+
+func sortedSettings(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
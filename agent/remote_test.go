@@ -0,0 +1,92 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestRemoteSettings(t *testing.T) {
+	a, b := &fakeCheck{name: "a"}, &fakeCheck{name: "b"}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.EnabledChecks = []string{"a"}
+	cfg.CheckIntervals = map[string]time.Duration{"a": time.Minute, "b": time.Minute}
+	cfg.ProcLimit = 10
+	cfg.Blacklist = []*regexp.Regexp{regexp.MustCompile("^local$")}
+	l := newTestCollector(cfg, []checks.Check{a, b, checks.RTProcess})
+
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2, Settings: &model.RemoteSettings{
+		CheckIntervals:    map[string]int32{"a": 1, "b": 30, "rtprocess": 10, "unknown": 10},
+		Checks:            map[string]bool{"a": false, "b": true, "unknown": true},
+		ProcLimit:         int32(config.MaxProcLimit + 1),
+		BlacklistPatterns: []string{"^remote$", "("},
+	}})
+	remote := l.agentConfig()
+	// The intervals are bounded, the real-time and unknown checks are ignored
+	assert.Equal(t, map[string]time.Duration{"a": minRemoteInterval, "b": 30 * time.Second}, remote.CheckIntervals)
+	// The checks can be disabled, not enabled when they are disabled locally
+	assert.Empty(t, remote.EnabledChecks)
+	assert.Equal(t, config.MaxProcLimit, remote.ProcLimit)
+	// The invalid patterns are ignored, the remote ones extend the local ones
+	assert.Len(t, remote.Blacklist, 2)
+	assert.Len(t, cfg.Blacklist, 1)
+	assert.Equal(t, map[string]string{
+		"interval.a":         "2s",
+		"interval.b":         "30s",
+		"check.a":            "disabled",
+		"check.b":            "ignored (disabled locally)",
+		"proc_limit":         "100",
+		"blacklist_patterns": "[^remote$]",
+	}, publishRemoteSettings())
+	// The local configuration is left untouched
+	assert.Equal(t, []string{"a"}, cfg.EnabledChecks)
+	assert.Equal(t, time.Minute, cfg.CheckIntervals["a"])
+
+	// The same settings are only applied once
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2, Settings: &model.RemoteSettings{
+		CheckIntervals:    map[string]int32{"a": 1, "b": 30, "rtprocess": 10, "unknown": 10},
+		Checks:            map[string]bool{"a": false, "b": true, "unknown": true},
+		ProcLimit:         int32(config.MaxProcLimit + 1),
+		BlacklistPatterns: []string{"^remote$", "("},
+	}})
+	assert.True(t, remote == l.agentConfig())
+
+	// The settings no longer pushed revert to the local configuration, as well
+	// as a reload keeping the remote settings on top of the new configuration.
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2, Settings: &model.RemoteSettings{
+		ProcLimit: 50,
+	}})
+	assert.Equal(t, cfg.CheckIntervals, l.agentConfig().CheckIntervals)
+	assert.Equal(t, []string{"a"}, l.agentConfig().EnabledChecks)
+	assert.Equal(t, 50, l.agentConfig().ProcLimit)
+	assert.Equal(t, cfg.Blacklist, l.agentConfig().Blacklist)
+
+	// A check disabled locally stays disabled when the intake enables it
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2, Settings: &model.RemoteSettings{
+		Checks:    map[string]bool{"a": true, "b": true},
+		ProcLimit: 50,
+	}})
+	assert.Equal(t, []string{"a"}, l.agentConfig().EnabledChecks)
+
+	cfg2 := config.NewDefaultAgentConfig()
+	cfg2.EnabledChecks = []string{"b"}
+	assert.NoError(t, l.reload(cfg2))
+	assert.Equal(t, []string{"b"}, l.agentConfig().EnabledChecks)
+	assert.Equal(t, 50, l.agentConfig().ProcLimit)
+	// The status follows the checks enabled by the reloaded configuration
+	assert.Equal(t, map[string]string{
+		"check.a":    "ignored (disabled locally)",
+		"check.b":    "enabled",
+		"proc_limit": "50",
+	}, publishRemoteSettings())
+
+	l.updateStatus(&model.CollectorStatus{ActiveClients: 0, Interval: 2, Settings: &model.RemoteSettings{}})
+	assert.Equal(t, cfg2, l.agentConfig())
+	assert.Empty(t, publishRemoteSettings())
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	watchInterval = 2 * time.Second
+	watchMaxRows  = 50
+	clearScreen   = "\033[H\033[2J"
+)
+
+// watchSortKeys maps the keys typed while watching to the sort orders.
+var watchSortKeys = map[string]string{"c": "cpu", "m": "mem", "i": "io", "f": "fds"}
+
+// watchOptions are the sort order and filters of the --watch mode.
+type watchOptions struct {
+	sortBy    string
+	user      string
+	container string
+	cmd       *regexp.Regexp
+}
+
+// watchRow is a process as displayed in the --watch mode. It is filled from both
+// the process and the real-time process checks, the latter not reporting the
+// user and command line.
+type watchRow struct {
+	pid       int32
+	user      string
+	container string
+	command   string
+	cpu       float32
+	rss       uint64
+	io        float32
+	fds       int32
+}
+
+// watchCheck runs the process or real-time process check continuously and
+// redraws its results as a table, until "q" is read from input or exit is closed.
+func watchCheck(cfg *config.AgentConfig, ch checks.Check, opts watchOptions, out io.Writer, input io.Reader, exit chan bool) error {
+	switch ch.Name() {
+	case "process", "rtprocess":
+	default:
+		return fmt.Errorf("--watch is only supported by the process and rtprocess checks")
+	}
+	switch opts.sortBy {
+	case "cpu", "mem", "io", "fds":
+	default:
+		return fmt.Errorf("invalid sort '%s', choose from: cpu, mem, io, fds", opts.sortBy)
+	}
+	// The real-time check doesn't report the users and the command lines.
+	if ch.Name() == "rtprocess" && (opts.user != "" || opts.cmd != nil) {
+		return fmt.Errorf("--filter-user and --filter-cmd aren't supported by the rtprocess check")
+	}
+
+	// The reader stops at the first line read once the watch returns, as reads
+	// can't be interrupted.
+	commands := make(chan string)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			select {
+			case commands <- strings.TrimSpace(scanner.Text()):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// Run the check once to prime the cache.
+	if _, err := ch.Run(cfg, 0); err != nil {
+		return fmt.Errorf("collection error: %s", err)
+	}
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var rows []watchRow
+	for {
+		select {
+		case <-ticker.C:
+			msgs, err := ch.Run(cfg, 0)
+			if err != nil {
+				return fmt.Errorf("collection error: %s", err)
+			}
+			rows = watchRows(msgs)
+		case c := <-commands:
+			if c == "q" {
+				return nil
+			}
+			if sortBy, ok := watchSortKeys[c]; ok {
+				opts.sortBy = sortBy
+			}
+		case <-exit:
+			return nil
+		}
+		fmt.Fprint(out, clearScreen)
+		renderWatch(out, ch.Name(), rows, opts, time.Now())
+	}
+}
+
+// watchRows extracts the processes of the messages returned by a check run.
+func watchRows(msgs []model.MessageBody) []watchRow {
+	var rows []watchRow
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			for _, p := range msg.Processes {
+				r := watchRow{pid: p.Pid, container: p.ContainerId, fds: p.OpenFdCount}
+				if p.User != nil {
+					r.user = p.User.Name
+				}
+				if p.Command != nil {
+					r.command = strings.Join(p.Command.Args, " ")
+				}
+				r.cpu, r.rss, r.io = watchStats(p.Cpu, p.Memory, p.IoStat)
+				rows = append(rows, r)
+			}
+		case *model.CollectorRealTime:
+			for _, s := range msg.Stats {
+				r := watchRow{pid: s.Pid, container: s.ContainerId, fds: s.OpenFdCount}
+				r.cpu, r.rss, r.io = watchStats(s.Cpu, s.Memory, s.IoStat)
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func watchStats(cpu *model.CPUStat, mem *model.MemoryStat, ioStat *model.IOStat) (float32, uint64, float32) {
+	var c, i float32
+	var rss uint64
+	if cpu != nil {
+		c = cpu.TotalPct
+	}
+	if mem != nil {
+		rss = mem.Rss
+	}
+	if ioStat != nil {
+		i = ioStat.ReadBytesRate + ioStat.WriteBytesRate
+	}
+	return c, rss, i
+}
+
+// filterWatchRows returns the rows matching the filters, sorted by the sort order.
+func filterWatchRows(rows []watchRow, opts watchOptions) []watchRow {
+	filtered := make([]watchRow, 0, len(rows))
+	for _, r := range rows {
+		if opts.user != "" && r.user != opts.user {
+			continue
+		}
+		if opts.container != "" && !strings.HasPrefix(r.container, opts.container) {
+			continue
+		}
+		if opts.cmd != nil && !opts.cmd.MatchString(r.command) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	less := func(a, b watchRow) bool {
+		switch opts.sortBy {
+		case "mem":
+			return a.rss > b.rss
+		case "io":
+			return a.io > b.io
+		case "fds":
+			return a.fds > b.fds
+		default:
+			return a.cpu > b.cpu
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if less(filtered[i], filtered[j]) {
+			return true
+		}
+		if less(filtered[j], filtered[i]) {
+			return false
+		}
+		return filtered[i].pid < filtered[j].pid
+	})
+	return filtered
+}
+
+func renderWatch(out io.Writer, check string, rows []watchRow, opts watchOptions, now time.Time) {
+	shown := filterWatchRows(rows, opts)
+	fmt.Fprintf(out, "%s - check %s - %d processes, %d matching - sorted by %s\n",
+		now.Format("15:04:05"), check, len(rows), len(shown), opts.sortBy)
+	fmt.Fprintf(out, "Sort with c (cpu), m (mem), i (io) or f (fds) then enter, q to quit\n\n")
+	if len(shown) > watchMaxRows {
+		shown = shown[:watchMaxRows]
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "PID\tUSER\tCPU%%\tRSS\tIO/S\tFDS\tCONTAINER\tCOMMAND\n")
+	for _, r := range shown {
+		fds := fmt.Sprintf("%d", r.fds)
+		if r.fds < 0 {
+			fds = "-"
+		}
+		container := r.container
+		if len(container) > 12 {
+			container = container[:12]
+		}
+		fmt.Fprintf(w, "%d\t%s\t%.1f\t%s\t%s\t%s\t%s\t%s\n",
+			r.pid, r.user, r.cpu, formatBytes(float64(r.rss)), formatBytes(float64(r.io)), fds, container, r.command)
+	}
+	w.Flush()
+}
+
+// formatBytes formats a number of bytes with a binary unit.
+func formatBytes(b float64) string {
+	units := []string{"B", "K", "M", "G", "T"}
+	i := 0
+	for b >= 1024 && i < len(units)-1 {
+		b /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f%s", b, units[i])
+	}
+	return fmt.Sprintf("%.1f%s", b, units[i])
+}
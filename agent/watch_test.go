@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func testWatchMessages() []model.MessageBody {
+	return []model.MessageBody{
+		&model.CollectorProc{Processes: []*model.Process{
+			{
+				Pid:         1,
+				User:        &model.ProcessUser{Name: "root"},
+				Command:     &model.Command{Args: []string{"/sbin/init"}},
+				Cpu:         &model.CPUStat{TotalPct: 1},
+				Memory:      &model.MemoryStat{Rss: 4096},
+				OpenFdCount: 50,
+			},
+			{
+				Pid:         42,
+				User:        &model.ProcessUser{Name: "redis"},
+				Command:     &model.Command{Args: []string{"redis-server", "*:6379"}},
+				Cpu:         &model.CPUStat{TotalPct: 20},
+				Memory:      &model.MemoryStat{Rss: 3 * 1024 * 1024},
+				IoStat:      &model.IOStat{ReadBytesRate: 1024, WriteBytesRate: 1024},
+				OpenFdCount: 10,
+				ContainerId: "0123456789abcdef",
+			},
+		}},
+		&model.CollectorProc{Processes: []*model.Process{
+			{
+				Pid:         43,
+				User:        &model.ProcessUser{Name: "redis"},
+				Command:     &model.Command{Args: []string{"redis-sentinel"}},
+				Cpu:         &model.CPUStat{TotalPct: 5},
+				OpenFdCount: -1,
+				ContainerId: "fedcba9876543210",
+			},
+		}},
+	}
+}
+
+func watchPids(rows []watchRow) []int32 {
+	pids := make([]int32, 0, len(rows))
+	for _, r := range rows {
+		pids = append(pids, r.pid)
+	}
+	return pids
+}
+
+func TestFilterWatchRows(t *testing.T) {
+	rows := watchRows(testWatchMessages())
+	assert.Len(t, rows, 3)
+
+	for sortBy, expected := range map[string][]int32{
+		"cpu": {42, 43, 1},
+		"mem": {42, 1, 43},
+		"io":  {42, 1, 43},
+		"fds": {1, 42, 43},
+	} {
+		assert.Equal(t, expected, watchPids(filterWatchRows(rows, watchOptions{sortBy: sortBy})), sortBy)
+	}
+
+	assert.Equal(t, []int32{42, 43}, watchPids(filterWatchRows(rows, watchOptions{sortBy: "cpu", user: "redis"})))
+	assert.Equal(t, []int32{42}, watchPids(filterWatchRows(rows, watchOptions{sortBy: "cpu", container: "0123"})))
+	assert.Equal(t, []int32{43}, watchPids(filterWatchRows(rows, watchOptions{sortBy: "cpu", cmd: regexp.MustCompile("sentinel$")})))
+
+	// Real-time stats don't have a command line
+	rt := watchRows([]model.MessageBody{&model.CollectorRealTime{Stats: []*model.ProcessStat{
+		{Pid: 42, Cpu: &model.CPUStat{TotalPct: 3}, ContainerId: "0123456789abcdef"},
+	}}})
+	assert.Equal(t, []watchRow{{pid: 42, cpu: 3, container: "0123456789abcdef"}}, rt)
+}
+
+func TestRenderWatch(t *testing.T) {
+	var out bytes.Buffer
+	now := time.Date(2018, 3, 1, 10, 0, 0, 0, time.UTC)
+	renderWatch(&out, "process", watchRows(testWatchMessages()), watchOptions{sortBy: "cpu", user: "redis"}, now)
+
+	lines := strings.Split(out.String(), "\n")
+	assert.Equal(t, "10:00:00 - check process - 3 processes, 2 matching - sorted by cpu", lines[0])
+	assert.Equal(t, "PID  USER   CPU%  RSS   IO/S  FDS  CONTAINER     COMMAND", lines[3])
+	assert.Equal(t, "42   redis  20.0  3.0M  2.0K  10   0123456789ab  redis-server *:6379", lines[4])
+	assert.Equal(t, "43   redis  5.0   0B    0B    -    fedcba987654  redis-sentinel", lines[5])
+}
+
+func TestWatchCheckErrors(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	assert.Error(t, watchCheck(cfg, checks.Connections, watchOptions{sortBy: "cpu"}, nil, nil, nil))
+	assert.Error(t, watchCheck(cfg, checks.Process, watchOptions{sortBy: "name"}, nil, nil, nil))
+	// The real-time processes have no user nor command line to filter on
+	assert.Error(t, watchCheck(cfg, checks.RTProcess, watchOptions{sortBy: "cpu", user: "redis"}, nil, nil, nil))
+	assert.Error(t, watchCheck(cfg, checks.RTProcess, watchOptions{sortBy: "cpu", cmd: regexp.MustCompile("redis")}, nil, nil, nil))
+}
@@ -8,7 +8,8 @@ import (
 // Check is an interface for Agent checks that collect data. Each check returns
 // a specific MessageBody type that will be published to the intake endpoint or
 // processed in another way (e.g. printed for debugging).
-// Before checks are used you must called Init.
+// Before checks are used you must called Init. Init is called again from the
+// routine running the check whenever the SystemInfo changes.
 type Check interface {
 	Init(cfg *config.AgentConfig, info *model.SystemInfo)
 	Name() string
@@ -24,4 +25,6 @@ var All = []Check{
 	Container,
 	RTContainer,
 	Connections,
+	SystemStats,
+	RTSystemStats,
 }
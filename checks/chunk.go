@@ -0,0 +1,147 @@
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// itemRange is the [start, end) range of the items of a chunk.
+type itemRange struct {
+	start, end int
+}
+
+// chunkRanges returns the ranges of the chunks of items, in order. When they are
+// set, a chunk holds at most maxCount items, adding up to at most maxBytes once
+// encoded, sizes being the encoded sizes of the items. A chunk always holds at
+// least one item, even if it's larger than maxBytes.
+func chunkRanges(sizes []int, maxCount, maxBytes int) []itemRange {
+	var ranges []itemRange
+	start, bytes := 0, 0
+	for i, size := range sizes {
+		// The encoded size of an item in a repeated field of the message.
+		size += 1 + varintLen(uint64(size))
+		full := (maxCount > 0 && i-start == maxCount) || (maxBytes > 0 && bytes+size > maxBytes)
+		if i > start && full {
+			ranges = append(ranges, itemRange{start, i})
+			start, bytes = i, 0
+		}
+		bytes += size
+	}
+	if start < len(sizes) {
+		ranges = append(ranges, itemRange{start, len(sizes)})
+	}
+	return ranges
+}
+
+func varintLen(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// itemSizes returns the encoded sizes of n items.
+func itemSizes(n int, size func(i int) int) []int {
+	sizes := make([]int, n)
+	for i := range sizes {
+		sizes[i] = size(i)
+	}
+	return sizes
+}
+
+// messageRanges chunks the items of the two repeated fields of a group of
+// messages, whose other fields take overhead bytes, so that each message stays
+// under cfg.MaxMessageBytes, with at most cfg.ProcLimit items of the first
+// field. The bytes left are split between the fields in proportion of their
+// total size, the items of the second field being then spread over about as
+// many chunks as the first. Both fields get the same number of chunks, some
+// possibly empty, and there is always at least one.
+func messageRanges(cfg *config.AgentConfig, overhead int, sizes, otherSizes []int) ([]itemRange, []itemRange) {
+	available := maxInt(cfg.MaxMessageBytes-overhead, 1)
+	bytes, otherBytes := available, 0
+	if total, otherTotal := sum(sizes), sum(otherSizes); otherTotal > 0 {
+		bytes = maxInt(int(int64(available)*int64(total)/int64(total+otherTotal)), 1)
+		otherBytes = maxInt(available-bytes, 1)
+	}
+	ranges, otherRanges := chunkRanges(sizes, cfg.ProcLimit, bytes), chunkRanges(otherSizes, 0, otherBytes)
+	for len(ranges) < len(otherRanges) || len(ranges) == 0 {
+		ranges = append(ranges, itemRange{len(sizes), len(sizes)})
+	}
+	for len(otherRanges) < len(ranges) {
+		otherRanges = append(otherRanges, itemRange{len(otherSizes), len(otherSizes)})
+	}
+	return ranges, otherRanges
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// chunkProcessesBySize chunks the processes and the containers of a
+// CollectorProc group so that each message, whose other fields take overhead
+// bytes, stays under cfg.MaxMessageBytes and holds at most cfg.ProcLimit
+// processes. Every chunk of processes keeps its chunk of containers, either of
+// them possibly empty.
+func chunkProcessesBySize(cfg *config.AgentConfig, overhead int, allProcs []*model.Process, allCtrs []*model.Container) ([][]*model.Process, [][]*model.Container) {
+	procRanges, ctrRanges := messageRanges(cfg, overhead,
+		itemSizes(len(allProcs), func(i int) int { return allProcs[i].Size() }),
+		itemSizes(len(allCtrs), func(i int) int { return allCtrs[i].Size() }))
+	chunkedProcs := make([][]*model.Process, len(procRanges))
+	chunkedCtrs := make([][]*model.Container, len(ctrRanges))
+	for i, r := range procRanges {
+		chunkedProcs[i] = allProcs[r.start:r.end:r.end]
+		chunkedCtrs[i] = allCtrs[ctrRanges[i].start:ctrRanges[i].end:ctrRanges[i].end]
+	}
+	return chunkedProcs, chunkedCtrs
+}
+
+// chunkProcessStatsBySize is chunkProcessesBySize for a CollectorRealTime group.
+func chunkProcessStatsBySize(cfg *config.AgentConfig, overhead int, allStats []*model.ProcessStat, allCtrStats []*model.ContainerStat) ([][]*model.ProcessStat, [][]*model.ContainerStat) {
+	statRanges, ctrRanges := messageRanges(cfg, overhead,
+		itemSizes(len(allStats), func(i int) int { return allStats[i].Size() }),
+		itemSizes(len(allCtrStats), func(i int) int { return allCtrStats[i].Size() }))
+	chunkedStats := make([][]*model.ProcessStat, len(statRanges))
+	chunkedCtrStats := make([][]*model.ContainerStat, len(ctrRanges))
+	for i, r := range statRanges {
+		chunkedStats[i] = allStats[r.start:r.end:r.end]
+		chunkedCtrStats[i] = allCtrStats[ctrRanges[i].start:ctrRanges[i].end:ctrRanges[i].end]
+	}
+	return chunkedStats, chunkedCtrStats
+}
+
+// chunkContainersBySize chunks the containers of a CollectorContainer group so
+// that each message, whose other fields take overhead bytes, stays under
+// cfg.MaxMessageBytes and holds at most cfg.ProcLimit containers. There is
+// always at least one chunk.
+func chunkContainersBySize(cfg *config.AgentConfig, overhead int, all []*model.Container) [][]*model.Container {
+	ranges, _ := messageRanges(cfg, overhead, itemSizes(len(all), func(i int) int { return all[i].Size() }), nil)
+	chunked := make([][]*model.Container, len(ranges))
+	for i, r := range ranges {
+		chunked[i] = all[r.start:r.end:r.end]
+	}
+	return chunked
+}
+
+// chunkContainerStatsBySize is chunkContainersBySize for a
+// CollectorContainerRealTime group.
+func chunkContainerStatsBySize(cfg *config.AgentConfig, overhead int, all []*model.ContainerStat) [][]*model.ContainerStat {
+	ranges, _ := messageRanges(cfg, overhead, itemSizes(len(all), func(i int) int { return all[i].Size() }), nil)
+	chunked := make([][]*model.ContainerStat, len(ranges))
+	for i, r := range ranges {
+		chunked[i] = all[r.start:r.end:r.end]
+	}
+	return chunked
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,85 @@
+package checks
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestChunkRanges(t *testing.T) {
+	assert.Equal(t, []itemRange{{0, 2}, {2, 4}, {4, 5}}, chunkRanges([]int{1, 1, 1, 1, 1}, 2, 0))
+	// Each item takes 2 more bytes in the message, a large item gets its own chunk
+	assert.Equal(t, []itemRange{{0, 3}, {3, 4}, {4, 6}}, chunkRanges([]int{8, 8, 8, 100, 8, 8}, 100, 30))
+	assert.Equal(t, []itemRange{{0, 1}}, chunkRanges([]int{100}, 100, 30))
+	assert.Empty(t, chunkRanges(nil, 100, 30))
+	// Both limits apply
+	assert.Equal(t, []itemRange{{0, 2}, {2, 4}, {4, 5}, {5, 6}}, chunkRanges([]int{8, 8, 8, 8, 20, 8}, 2, 30))
+}
+
+func TestChunkProcessesBySize(t *testing.T) {
+	procs := make(map[int32]*process.FilledProcess)
+	for i := int32(1); i <= 50; i++ {
+		procs[i] = makeProcess(i, "python -c "+strings.Repeat("x", int(i)*10))
+	}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.MaxMessageBytes = 2000
+
+	// The processes are only chunked by size, along with the containers
+	lastRun := time.Now()
+	formatted, usages := formatProcesses(cfg, procs, procs, nil, cpu.TimesStat{}, cpu.TimesStat{}, lastRun)
+	chunked, _ := sampleProcessChunks(cfg, formatted, usages, lastRun)
+	if !assert.Len(t, chunked, 1) || !assert.Len(t, chunked[0], 50) {
+		return
+	}
+
+	// The chunks hold as many processes as fit
+	chunkedProcs, _ := chunkProcessesBySize(cfg, 0, chunked[0], nil)
+	total := 0
+	for _, chunk := range chunkedProcs {
+		total += len(chunk)
+		size := (&model.CollectorProc{Processes: chunk}).Size()
+		assert.True(t, size <= cfg.MaxMessageBytes, "chunk of %d bytes", size)
+	}
+	assert.Equal(t, 50, total)
+	assert.True(t, len(chunkedProcs) > 1 && len(chunkedProcs) < 50, "%d chunks", len(chunkedProcs))
+
+	// The containers are spread over as many chunks, within the same budget
+	ctrs := make([]*model.Container, 0, 30)
+	for i := 0; i < 30; i++ {
+		ctrs = append(ctrs, &model.Container{Id: strings.Repeat("c", 64), Image: "nginx:1.13"})
+	}
+	chunkedProcs, chunkedCtrs := chunkProcessesBySize(cfg, 100, chunked[0], ctrs)
+	assert.Equal(t, len(chunkedProcs), len(chunkedCtrs))
+	total, totalCtrs := 0, 0
+	for i := range chunkedProcs {
+		total += len(chunkedProcs[i])
+		totalCtrs += len(chunkedCtrs[i])
+		size := (&model.CollectorProc{Processes: chunkedProcs[i], Containers: chunkedCtrs[i]}).Size()
+		assert.True(t, size+100 <= cfg.MaxMessageBytes, "chunk of %d bytes", size)
+	}
+	assert.Equal(t, 50, total)
+	assert.Equal(t, 30, totalCtrs)
+
+	// There is always a chunk of containers
+	empty := chunkContainersBySize(cfg, 100, nil)
+	if assert.Len(t, empty, 1) {
+		assert.Empty(t, empty[0])
+	}
+
+	// And never more processes than ProcLimit
+	cfg.MaxMessageBytes = 1 << 20
+	cfg.ProcLimit = 20
+	chunkedProcs, chunkedCtrs = chunkProcessesBySize(cfg, 100, chunked[0], ctrs)
+	if assert.Len(t, chunkedProcs, 3) {
+		assert.Len(t, chunkedProcs[0], 20)
+		assert.Len(t, chunkedProcs[2], 10)
+		assert.Len(t, chunkedCtrs, 3)
+	}
+}
@@ -60,7 +60,22 @@ func (c *ContainerCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 	if len(containers) != cfg.ProcLimit {
 		groupSize++
 	}
-	chunked := fmtContainers(containers, c.lastContainers, c.lastRun, groupSize)
+	// The containers are chunked by size once the size of the rest of the
+	// messages is known.
+	if cfg.MaxMessageBytes > 0 {
+		groupSize = 1
+	}
+	chunked := fmtContainers(cfg, containers, c.lastContainers, c.lastRun, groupSize)
+	if cfg.MaxMessageBytes > 0 {
+		overhead := (&model.CollectorContainer{
+			HostName:  cfg.HostName,
+			Info:      c.sysInfo,
+			GroupId:   groupID,
+			GroupSize: int32(groupSize),
+		}).Size()
+		chunked = chunkContainersBySize(cfg, overhead, chunked[0])
+		groupSize = len(chunked)
+	}
 	messages := make([]model.MessageBody, 0, groupSize)
 	totalContainers := float64(0)
 	for i := 0; i < groupSize; i++ {
@@ -85,6 +100,7 @@ func (c *ContainerCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 // fmtContainers formats and chunks the containers into a slice of chunks using a specific
 // number of chunks. len(result) MUST EQUAL chunks.
 func fmtContainers(
+	cfg *config.AgentConfig,
 	containers, lastContainers []*docker.Container,
 	lastRun time.Time,
 	chunks int,
@@ -118,6 +134,16 @@ func fmtContainers(
 			tags = []string{}
 		}
 
+		// Retrieves the image, command, labels and ports. We will still ship the
+		// container with partial metadata if the container can't be inspected.
+		meta, err := container.GetMetadata(ctr)
+		if err != nil {
+			log.Debugf("unable to retrieve metadata for container %s: %s", ctr.ID, err)
+		}
+		if meta == nil {
+			meta = &container.Metadata{}
+		}
+
 		chunk = append(chunk, &model.Container{
 			Id:          ctr.ID,
 			Type:        ctr.Type,
@@ -139,6 +165,13 @@ func fmtContainers(
 			NetSentBps:  calculateRate(ifStats.BytesSent, lastIfStats.BytesSent, lastRun),
 			Started:     ctr.StartedAt,
 			Tags:        tags,
+			ImageName:   meta.ImageName,
+			ImageTag:    meta.ImageTag,
+			ImageDigest: meta.ImageDigest,
+			Command:     cfg.Scrubber.ScrubCmdline(meta.Command),
+			Entrypoint:  cfg.Scrubber.ScrubCmdline(meta.Entrypoint),
+			Labels:      container.FilterLabels(meta.Labels, cfg.ContainerLabelWhitelist),
+			Ports:       formatContainerPorts(meta.Ports),
 		})
 
 		if len(chunk) == perChunk {
@@ -153,6 +186,20 @@ func fmtContainers(
 	return chunked
 }
 
+func formatContainerPorts(ports []container.Port) []*model.ContainerPort {
+	if len(ports) == 0 {
+		return nil
+	}
+	ps := make([]*model.ContainerPort, 0, len(ports))
+	for _, p := range ports {
+		ps = append(ps, &model.ContainerPort{
+			Port:     int32(p.Port),
+			Protocol: p.Protocol,
+		})
+	}
+	return ps
+}
+
 func calculateCtrPct(cur, prev, sys2, sys1 uint64, numCPU int, before time.Time) float32 {
 	now := time.Now()
 	diff := now.Unix() - before.Unix()
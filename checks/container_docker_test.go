@@ -0,0 +1,39 @@
+// +build docker
+
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/docker"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerImageMetadata(t *testing.T) {
+	ctr := makeContainer("with-image")
+	ctr.Image = "datadog/agent:6.1.0"
+	ctr.ImageID = "sha256:0123abcd"
+	cfg := config.NewDefaultAgentConfig()
+
+	chunked := fmtContainers(cfg, []*docker.Container{ctr}, nil, time.Now(), 1)
+	assert.Len(t, chunked, 1)
+	assert.Len(t, chunked[0], 1)
+	c := chunked[0][0]
+	assert.Equal(t, "datadog/agent", c.ImageName)
+	assert.Equal(t, "6.1.0", c.ImageTag)
+	// The local image ID isn't a registry digest
+	assert.Equal(t, "", c.ImageDigest)
+
+	ctr = makeContainer("with-digest")
+	ctr.Image = "datadog/agent:6.1.0@sha256:4567ef"
+	ctr.ImageID = "sha256:0123abcd"
+	chunked = fmtContainers(cfg, []*docker.Container{ctr}, nil, time.Now(), 1)
+	if assert.Len(t, chunked, 1) && assert.Len(t, chunked[0], 1) {
+		c = chunked[0][0]
+		assert.Equal(t, "datadog/agent", c.ImageName)
+		assert.Equal(t, "6.1.0", c.ImageTag)
+		assert.Equal(t, "sha256:4567ef", c.ImageDigest)
+	}
+}
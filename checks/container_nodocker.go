@@ -44,6 +44,7 @@ func (c *ContainerCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 // fmtContainers formats and chunks the containers into a slice of chunks using a specific
 // number of chunks. len(result) MUST EQUAL chunks.
 func fmtContainers(
+	cfg *config.AgentConfig,
 	containers, lastContainers []*docker.Container,
 	lastRun time.Time,
 	chunks int,
@@ -54,7 +54,23 @@ func (r *RTContainerCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.
 	if len(containers) != cfg.ProcLimit {
 		groupSize++
 	}
+	// The containers are chunked by size once the size of the rest of the
+	// messages is known.
+	if cfg.MaxMessageBytes > 0 {
+		groupSize = 1
+	}
 	chunked := fmtContainerStats(containers, r.lastContainers, r.lastRun, groupSize)
+	if cfg.MaxMessageBytes > 0 {
+		overhead := (&model.CollectorContainerRealTime{
+			HostName:    cfg.HostName,
+			NumCpus:     int32(runtime.NumCPU()),
+			TotalMemory: r.sysInfo.TotalMemory,
+			GroupId:     groupID,
+			GroupSize:   int32(groupSize),
+		}).Size()
+		chunked = chunkContainerStatsBySize(cfg, overhead, chunked[0])
+		groupSize = len(chunked)
+	}
 	messages := make([]model.MessageBody, 0, groupSize)
 	for i := 0; i < groupSize; i++ {
 		messages = append(messages, &model.CollectorContainerRealTime{
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/util/docker"
+	"github.com/DataDog/datadog-process-agent/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +25,7 @@ func TestContainerChunking(t *testing.T) {
 		makeContainer("bim"),
 	}
 	lastRun := time.Now().Add(-5 * time.Second)
+	cfg := config.NewDefaultAgentConfig()
 
 	for i, tc := range []struct {
 		cur, last []*docker.Container
@@ -49,7 +51,7 @@ func TestContainerChunking(t *testing.T) {
 			expected: 2,
 		},
 	} {
-		chunked := fmtContainers(tc.cur, tc.last, lastRun, tc.chunks)
+		chunked := fmtContainers(cfg, tc.cur, tc.last, lastRun, tc.chunks)
 		assert.Len(t, chunked, tc.chunks, "len test %d", i)
 		total := 0
 		for _, c := range chunked {
@@ -1,6 +1,8 @@
 package checks
 
 import (
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/DataDog/gopsutil/cpu"
@@ -70,14 +72,30 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 		return nil, nil
 	}
 
-	chunkedProcs := fmtProcesses(cfg, procs, p.lastProcs,
+	formatted, usages := formatProcesses(cfg, procs, p.lastProcs,
 		containers, cpuTimes[0], p.lastCPUTime, p.lastRun)
+	chunkedProcs, omitted := sampleProcessChunks(cfg, formatted, usages, p.lastRun)
+	lastSample.set(chunkedProcs, omitted)
 	// In case we skip every process..
 	if len(chunkedProcs) == 0 {
 		return nil, nil
 	}
 	groupSize := len(chunkedProcs)
-	chunkedContainers := fmtContainers(containers, p.lastContainers, p.lastRun, groupSize)
+	chunkedContainers := fmtContainers(cfg, containers, p.lastContainers, p.lastRun, groupSize)
+	// The containers are rolled up from all their processes before the sampling,
+	// so that their counts don't change with the sample.
+	rollupContainerProcesses([][]*model.Process{formatted}, chunkedContainers, cfg.ContainerTopProcesses)
+	if cfg.MaxMessageBytes > 0 {
+		overhead := (&model.CollectorProc{
+			HostName:  cfg.HostName,
+			Info:      p.sysInfo,
+			GroupId:   groupID,
+			GroupSize: int32(groupSize),
+			Omitted:   omitted,
+		}).Size()
+		chunkedProcs, chunkedContainers = chunkProcessesBySize(cfg, overhead, chunkedProcs[0], chunkedContainers[0])
+		groupSize = len(chunkedProcs)
+	}
 	messages := make([]model.MessageBody, 0, groupSize)
 	totalProcs, totalContainers := float64(0), float64(0)
 	for i := 0; i < groupSize; i++ {
@@ -92,6 +110,7 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 			GroupSize:  int32(groupSize),
 		})
 	}
+	messages[0].(*model.CollectorProc).Omitted = omitted
 
 	// Store the last state for comparison on the next run.
 	// Note: not storing the filtered in case there are new processes that haven't had a chance to show up twice.
@@ -106,13 +125,15 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 	return messages, nil
 }
 
-func fmtProcesses(
+// formatProcesses formats the processes which aren't skipped, along with their
+// usage for the sampling.
+func formatProcesses(
 	cfg *config.AgentConfig,
 	procs, lastProcs map[int32]*process.FilledProcess,
 	containers []*docker.Container,
 	syst2, syst1 cpu.TimesStat,
 	lastRun time.Time,
-) [][]*model.Process {
+) ([]*model.Process, []processUsage) {
 	ctrByPid := make(map[int32]*docker.Container, len(containers))
 	for _, c := range containers {
 		for _, p := range c.Pids {
@@ -120,22 +141,21 @@ func fmtProcesses(
 		}
 	}
 
-	chunked := make([][]*model.Process, 0)
-	chunk := make([]*model.Process, 0, cfg.ProcLimit)
+	formatted := make([]*model.Process, 0, len(procs))
+	usages := make([]processUsage, 0, len(procs))
 	for _, fp := range procs {
-		if skipProcess(cfg, fp, lastProcs) {
+		ctr, ok := ctrByPid[fp.Pid]
+		if !ok {
+			ctr = docker.NullContainer
+		}
+		if skipProcess(cfg, fp, lastProcs, ctr, syst2, syst1) {
 			continue
 		}
 
 		// Hide blacklisted args if the Scrubber is enabled
 		fp.Cmdline = cfg.Scrubber.ScrubCmdline(fp.Cmdline)
 
-		ctr, ok := ctrByPid[fp.Pid]
-		if !ok {
-			ctr = docker.NullContainer
-		}
-
-		chunk = append(chunk, &model.Process{
+		proc := &model.Process{
 			Pid:                    fp.Pid,
 			Command:                formatCommand(fp),
 			User:                   formatUser(fp),
@@ -148,16 +168,35 @@ func fmtProcesses(
 			VoluntaryCtxSwitches:   uint64(fp.CtxSwitches.Voluntary),
 			InvoluntaryCtxSwitches: uint64(fp.CtxSwitches.Involuntary),
 			ContainerId:            ctr.ID,
-		})
-		if len(chunk) == cfg.ProcLimit {
-			chunked = append(chunked, chunk)
-			chunk = make([]*model.Process, 0, cfg.ProcLimit)
 		}
+		formatted = append(formatted, proc)
+		usages = append(usages, newProcessUsage(proc.Pid, proc.CreateTime, proc.Cpu, proc.Memory, proc.IoStat, proc.OpenFdCount))
 	}
-	if len(chunk) > 0 {
-		chunked = append(chunked, chunk)
+	return formatted, usages
+}
+
+// sampleProcessChunks samples the formatted processes and chunks the ones kept.
+func sampleProcessChunks(cfg *config.AgentConfig, formatted []*model.Process, usages []processUsage, lastRun time.Time) ([][]*model.Process, *model.OmittedProcesses) {
+	// The sample rotates with the collections.
+	keep, omitted := sampleProcesses(usages, cfg.ProcessSampleMax, cfg.ProcessSampleTopN, lastRun.UnixNano())
+	kept := make([]*model.Process, 0, len(formatted))
+	for i, proc := range formatted {
+		if keep[i] {
+			kept = append(kept, proc)
+		}
 	}
-	return chunked
+	// When chunking by size, the processes are chunked once the size of the rest
+	// of the messages is known and are kept in a single chunk until then.
+	maxCount := cfg.ProcLimit
+	if cfg.MaxMessageBytes > 0 {
+		maxCount = 0
+	}
+	ranges := chunkRanges(make([]int, len(kept)), maxCount, 0)
+	chunked := make([][]*model.Process, len(ranges))
+	for i, r := range ranges {
+		chunked[i] = kept[r.start:r.end:r.end]
+	}
+	return chunked, omitted
 }
 
 func formatCommand(fp *process.FilledProcess) *model.Command {
@@ -227,25 +266,97 @@ func formatMemory(fp *process.FilledProcess) *model.MemoryStat {
 	return ms
 }
 
-// skipProcess will skip a given process if it's blacklisted or hasn't existed
-// for multiple collections.
-func skipProcess(
-	cfg *config.AgentConfig,
-	fp *process.FilledProcess,
-	lastProcs map[int32]*process.FilledProcess,
-) bool {
-	if len(fp.Cmdline) == 0 {
-		return true
+// rollupContainerProcesses aggregates the processes by container and attaches
+// the process, thread and open file descriptor counts as well as the top processes
+// by CPU and memory usage to each of the containers.
+// Only the processes we collected are taken into account so blacklisted processes
+// are never part of the rollups.
+func rollupContainerProcesses(procs [][]*model.Process, containers [][]*model.Container, topN int) {
+	procsByCtr := make(map[string][]*model.Process)
+	for _, chunk := range procs {
+		for _, p := range chunk {
+			if p.ContainerId != "" {
+				procsByCtr[p.ContainerId] = append(procsByCtr[p.ContainerId], p)
+			}
+		}
+	}
+
+	for _, chunk := range containers {
+		for _, c := range chunk {
+			ctrProcs := procsByCtr[c.Id]
+			c.ProcessCount = int32(len(ctrProcs))
+			c.ThreadCount, c.OpenFdCount = 0, 0
+			for _, p := range ctrProcs {
+				if p.Cpu != nil {
+					c.ThreadCount += p.Cpu.NumThreads
+				}
+				// A negative count means we couldn't read the file descriptors.
+				if p.OpenFdCount > 0 {
+					c.OpenFdCount += p.OpenFdCount
+				}
+			}
+			c.TopCpuProcesses = topContainerProcesses(ctrProcs, topN, func(a, b *model.Process) bool {
+				return processCPUPct(a) > processCPUPct(b)
+			})
+			c.TopMemProcesses = topContainerProcesses(ctrProcs, topN, func(a, b *model.Process) bool {
+				return processRSS(a) > processRSS(b)
+			})
+		}
+	}
+}
+
+// topContainerProcesses returns a summary of the first n processes using the given ordering.
+func topContainerProcesses(procs []*model.Process, n int, less func(a, b *model.Process) bool) []*model.ContainerProcess {
+	if n <= 0 || len(procs) == 0 {
+		return nil
+	}
+	sorted := make([]*model.Process, len(procs))
+	copy(sorted, procs)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+
+	top := make([]*model.ContainerProcess, 0, len(sorted))
+	for _, p := range sorted {
+		top = append(top, &model.ContainerProcess{
+			Pid:        p.Pid,
+			CreateTime: p.CreateTime,
+			Name:       ProcessName(p.Command),
+			CpuPct:     processCPUPct(p),
+			MemRss:     processRSS(p),
+		})
+	}
+	return top
+}
+
+func processCPUPct(p *model.Process) float32 {
+	if p.Cpu == nil {
+		return 0
+	}
+	return p.Cpu.TotalPct
+}
+
+func processRSS(p *model.Process) uint64 {
+	if p.Memory == nil {
+		return 0
+	}
+	return p.Memory.Rss
+}
+
+// ProcessName returns a short name for the process, the executable if available
+// and the first argument otherwise.
+func ProcessName(c *model.Command) string {
+	if c == nil {
+		return ""
 	}
-	if config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
-		return true
+	if c.Exe != "" {
+		return filepath.Base(c.Exe)
 	}
-	if _, ok := lastProcs[fp.Pid]; !ok {
-		// Skipping any processes that didn't exist in the previous run.
-		// This means short-lived processes (<2s) will never be captured.
-		return true
+	if len(c.Args) > 0 {
+		return filepath.Base(c.Args[0])
 	}
-	return false
+	return ""
 }
 
 // chunkProcesses chunks a slice of model.Process into `chunks` of equal size.
@@ -65,10 +65,22 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 		return nil, nil
 	}
 
-	chunkedStats := fmtProcessStats(cfg, procs, r.lastProcs,
+	chunkedStats, omitted := fmtProcessStats(cfg, procs, r.lastProcs,
 		containers, cpuTimes[0], r.lastCPUTime, r.lastRun)
 	groupSize := len(chunkedStats)
 	chunkedCtrStats := fmtContainerStats(containers, r.lastContainers, r.lastRun, groupSize)
+	if cfg.MaxMessageBytes > 0 {
+		overhead := (&model.CollectorRealTime{
+			HostName:    cfg.HostName,
+			GroupId:     groupID,
+			GroupSize:   int32(groupSize),
+			NumCpus:     int32(len(r.sysInfo.Cpus)),
+			TotalMemory: r.sysInfo.TotalMemory,
+			Omitted:     omitted,
+		}).Size()
+		chunkedStats, chunkedCtrStats = chunkProcessStatsBySize(cfg, overhead, chunkedStats[0], chunkedCtrStats[0])
+		groupSize = len(chunkedStats)
+	}
 	messages := make([]model.MessageBody, 0, groupSize)
 	for i := 0; i < groupSize; i++ {
 		messages = append(messages, &model.CollectorRealTime{
@@ -81,6 +93,9 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 			TotalMemory:    r.sysInfo.TotalMemory,
 		})
 	}
+	if len(messages) > 0 {
+		messages[0].(*model.CollectorRealTime).Omitted = omitted
+	}
 
 	// Store the last state for comparison on the next run.
 	// Note: not storing the filtered in case there are new processes that haven't had a chance to show up twice.
@@ -92,14 +107,15 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 	return messages, nil
 }
 
-// fmtProcessStats formats and chunks a slice of ProcessStat into chunks.
+// fmtProcessStats formats and chunks a slice of ProcessStat into chunks, with the
+// summary of the processes left out by the sampling.
 func fmtProcessStats(
 	cfg *config.AgentConfig,
 	procs, lastProcs map[int32]*process.FilledProcess,
 	containers []*docker.Container,
 	syst2, syst1 cpu.TimesStat,
 	lastRun time.Time,
-) [][]*model.ProcessStat {
+) ([][]*model.ProcessStat, *model.OmittedProcesses) {
 	ctrByPid := make(map[int32]*docker.Container, len(containers))
 	for _, c := range containers {
 		for _, p := range c.Pids {
@@ -107,19 +123,18 @@ func fmtProcessStats(
 		}
 	}
 
-	chunked := make([][]*model.ProcessStat, 0)
-	chunk := make([]*model.ProcessStat, 0, cfg.ProcLimit)
+	formatted := make([]*model.ProcessStat, 0, len(procs))
+	usages := make([]processUsage, 0, len(procs))
 	for _, fp := range procs {
-		if skipProcess(cfg, fp, lastProcs) {
-			continue
-		}
-
 		ctr, ok := ctrByPid[fp.Pid]
 		if !ok {
 			ctr = docker.NullContainer
 		}
+		if skipProcess(cfg, fp, lastProcs, ctr, syst2, syst1) {
+			continue
+		}
 
-		chunk = append(chunk, &model.ProcessStat{
+		stat := &model.ProcessStat{
 			Pid:                    fp.Pid,
 			CreateTime:             fp.CreateTime,
 			Memory:                 formatMemory(fp),
@@ -132,16 +147,31 @@ func fmtProcessStats(
 			VoluntaryCtxSwitches:   uint64(fp.CtxSwitches.Voluntary),
 			InvoluntaryCtxSwitches: uint64(fp.CtxSwitches.Involuntary),
 			ContainerId:            ctr.ID,
-		})
-		if len(chunk) == cfg.ProcLimit {
-			chunked = append(chunked, chunk)
-			chunk = make([]*model.ProcessStat, 0, cfg.ProcLimit)
 		}
+		formatted = append(formatted, stat)
+		usages = append(usages, newProcessUsage(stat.Pid, stat.CreateTime, stat.Cpu, stat.Memory, stat.IoStat, stat.OpenFdCount))
+	}
+
+	// The stats are those of the processes the process check collects
+	keep, omitted := sampleRealTime(cfg, usages, lastRun)
+	kept := make([]*model.ProcessStat, 0, len(formatted))
+	for i, stat := range formatted {
+		if keep[i] {
+			kept = append(kept, stat)
+		}
+	}
+	// Chunked by size along with the containers when MaxMessageBytes is set, see
+	// sampleProcessChunks.
+	maxCount := cfg.ProcLimit
+	if cfg.MaxMessageBytes > 0 {
+		maxCount = 0
 	}
-	if len(chunk) > 0 {
-		chunked = append(chunked, chunk)
+	ranges := chunkRanges(make([]int, len(kept)), maxCount, 0)
+	chunked := make([][]*model.ProcessStat, len(ranges))
+	for i, r := range ranges {
+		chunked[i] = kept[r.start:r.end:r.end]
 	}
-	return chunked
+	return chunked, omitted
 }
 
 func calculateRate(cur, prev uint64, before time.Time) float32 {
@@ -0,0 +1,156 @@
+package checks
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-agent/pkg/util/docker"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/util/container"
+)
+
+// ProcessFilterResult is the outcome of the filtering of a process, see
+// ExplainProcessRules.
+type ProcessFilterResult struct {
+	Pid       int32
+	Cmdline   []string
+	Collected bool
+	// Why the process is skipped or the rule including it, empty when it's
+	// collected without matching any rule.
+	Reason string
+}
+
+// skipProcess will skip a given process if it's blacklisted, excluded by the
+// process rules or hasn't existed for multiple collections.
+func skipProcess(
+	cfg *config.AgentConfig,
+	fp *process.FilledProcess,
+	lastProcs map[int32]*process.FilledProcess,
+	ctr *docker.Container,
+	syst2, syst1 cpu.TimesStat,
+) bool {
+	skip, _ := filterProcess(cfg, fp, lastProcs, ctr, syst2, syst1)
+	return skip
+}
+
+// filterProcess returns whether the process is skipped, and why.
+func filterProcess(
+	cfg *config.AgentConfig,
+	fp *process.FilledProcess,
+	lastProcs map[int32]*process.FilledProcess,
+	ctr *docker.Container,
+	syst2, syst1 cpu.TimesStat,
+) (bool, string) {
+	if len(fp.Cmdline) == 0 {
+		return true, "empty command line"
+	}
+	if config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+		return true, "blacklisted"
+	}
+	last, ok := lastProcs[fp.Pid]
+	if !ok {
+		// Skipping any processes that didn't exist in the previous run.
+		// This means short-lived processes (<2s) will never be captured.
+		return true, "not running at the previous collection"
+	}
+	if len(cfg.ProcessRules) == 0 {
+		return false, ""
+	}
+
+	collected, rule := config.MatchProcessRules(cfg.ProcessRules, processAttributes(fp, last, ctr, syst2, syst1))
+	switch {
+	case rule == nil && collected:
+		return false, ""
+	case rule == nil:
+		return true, "matched no include rule"
+	case collected:
+		return false, fmt.Sprintf("included by rule %s", rule.Name)
+	default:
+		return true, fmt.Sprintf("excluded by rule %s", rule.Name)
+	}
+}
+
+func processAttributes(fp, last *process.FilledProcess, ctr *docker.Container, syst2, syst1 cpu.TimesStat) *config.ProcessAttributes {
+	user := formatUser(fp)
+	attrs := &config.ProcessAttributes{
+		Cmdline:        fp.Cmdline,
+		Exe:            fp.Exe,
+		User:           user.Name,
+		UID:            user.Uid,
+		ContainerID:    ctr.ID,
+		ContainerImage: ctr.Image,
+		ContainerLabels: func() map[string]string {
+			meta, _ := container.GetMetadata(ctr)
+			if meta == nil {
+				return nil
+			}
+			return meta.Labels
+		},
+		State:  fp.Status,
+		CPUPct: float64(formatCPU(fp, fp.CpuTime, last.CpuTime, syst2, syst1).TotalPct),
+	}
+	if fp.MemInfo != nil {
+		attrs.RSS = fp.MemInfo.RSS
+	}
+	return attrs
+}
+
+// ExplainProcessRules collects the processes twice, interval apart, and returns
+// the outcome of their filtering by the blacklist and the process rules, sorted
+// by pid. The command lines are scrubbed.
+func ExplainProcessRules(cfg *config.AgentConfig, interval time.Duration) ([]ProcessFilterResult, error) {
+	cpuTimes1, err := cpu.Times(false)
+	if err != nil {
+		return nil, err
+	}
+	lastProcs, err := process.AllProcesses()
+	if err != nil {
+		return nil, err
+	}
+	time.Sleep(interval)
+	cpuTimes2, err := cpu.Times(false)
+	if err != nil {
+		return nil, err
+	}
+	procs, err := process.AllProcesses()
+	if err != nil {
+		return nil, err
+	}
+	containers, _ := container.GetContainers()
+	return explainProcessRules(cfg, procs, lastProcs, containers, cpuTimes2[0], cpuTimes1[0]), nil
+}
+
+func explainProcessRules(
+	cfg *config.AgentConfig,
+	procs, lastProcs map[int32]*process.FilledProcess,
+	containers []*docker.Container,
+	syst2, syst1 cpu.TimesStat,
+) []ProcessFilterResult {
+	ctrByPid := make(map[int32]*docker.Container, len(containers))
+	for _, c := range containers {
+		for _, p := range c.Pids {
+			ctrByPid[p] = c
+		}
+	}
+
+	results := make([]ProcessFilterResult, 0, len(procs))
+	for _, fp := range procs {
+		ctr, ok := ctrByPid[fp.Pid]
+		if !ok {
+			ctr = docker.NullContainer
+		}
+		skip, reason := filterProcess(cfg, fp, lastProcs, ctr, syst2, syst1)
+		results = append(results, ProcessFilterResult{
+			Pid:       fp.Pid,
+			Cmdline:   cfg.Scrubber.ScrubCmdline(fp.Cmdline),
+			Collected: !skip,
+			Reason:    reason,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Pid < results[j].Pid })
+	return results
+}
@@ -0,0 +1,204 @@
+package checks
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// lastSample holds the processes kept by the last run of the process check,
+// which the real-time check reports the stats of so that they match the
+// processes collected.
+var lastSample processSample
+
+// sampleKey identifies a process across runs, as pids get reused.
+type sampleKey struct {
+	pid        int32
+	createTime int64
+}
+
+type processSample struct {
+	mu sync.Mutex
+	// The processes kept, nil when the last run wasn't sampled.
+	keys map[sampleKey]bool
+}
+
+// set stores the processes kept by a run of the process check, omitted being
+// nil when all of them were kept.
+func (s *processSample) set(chunks [][]*model.Process, omitted *model.OmittedProcesses) {
+	var keys map[sampleKey]bool
+	if omitted != nil {
+		keys = make(map[sampleKey]bool)
+		for _, chunk := range chunks {
+			for _, p := range chunk {
+				keys[sampleKey{p.Pid, p.CreateTime}] = true
+			}
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *processSample) get() map[sampleKey]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keys
+}
+
+// processUsage is the resource usage of a process the sampling ranks it by.
+type processUsage struct {
+	pid        int32
+	createTime int64
+	cpuPct     float32
+	rss        uint64
+	readBytes  float32
+	writeBytes float32
+	fds        int32
+}
+
+func newProcessUsage(pid int32, createTime int64, cpu *model.CPUStat, mem *model.MemoryStat, io *model.IOStat, fds int32) processUsage {
+	u := processUsage{pid: pid, createTime: createTime, fds: fds}
+	if cpu != nil {
+		u.cpuPct = cpu.TotalPct
+	}
+	if mem != nil {
+		u.rss = mem.Rss
+	}
+	// The rates are -1 when the counters couldn't be read.
+	if io != nil {
+		if io.ReadBytesRate > 0 {
+			u.readBytes = io.ReadBytesRate
+		}
+		if io.WriteBytesRate > 0 {
+			u.writeBytes = io.WriteBytesRate
+		}
+	}
+	return u
+}
+
+// sampleProcesses returns which processes are kept when there are more than max
+// of them, and a summary of the others. The top topN processes by CPU, memory, IO
+// and file descriptors are always kept, the remaining room going to a sample of
+// the others which changes with the seed so that every process is regularly
+// collected. topN is lowered to max/4 so that no more than max processes are
+// kept. All the processes are kept when max is 0.
+func sampleProcesses(usages []processUsage, max, topN int, seed int64) ([]bool, *model.OmittedProcesses) {
+	keep := make([]bool, len(usages))
+	if max <= 0 || len(usages) <= max {
+		for i := range keep {
+			keep[i] = true
+		}
+		return keep, nil
+	}
+
+	if topN > max/4 {
+		topN = max / 4
+	}
+	kept := 0
+	byUsage := make([]int, len(usages))
+	for _, greater := range []func(a, b processUsage) bool{
+		func(a, b processUsage) bool { return a.cpuPct > b.cpuPct },
+		func(a, b processUsage) bool { return a.rss > b.rss },
+		func(a, b processUsage) bool { return a.readBytes+a.writeBytes > b.readBytes+b.writeBytes },
+		func(a, b processUsage) bool { return a.fds > b.fds },
+	} {
+		for i := range byUsage {
+			byUsage[i] = i
+		}
+		// Ties are broken by pid so that the same processes are picked whatever
+		// their order.
+		sort.Slice(byUsage, func(i, j int) bool {
+			a, b := usages[byUsage[i]], usages[byUsage[j]]
+			return greater(a, b) || (!greater(b, a) && a.pid < b.pid)
+		})
+		for _, i := range byUsage[:minInt(topN, len(byUsage))] {
+			if !keep[i] {
+				keep[i] = true
+				kept++
+			}
+		}
+	}
+
+	// The rest is ordered by a hash of the pid and the seed, so the sample is
+	// stable within a run and rotates between runs.
+	rest := make([]int, 0, len(usages)-kept)
+	for i := range usages {
+		if !keep[i] {
+			rest = append(rest, i)
+		}
+	}
+	sort.Slice(rest, func(i, j int) bool {
+		return sampleHash(usages[rest[i]].pid, seed) < sampleHash(usages[rest[j]].pid, seed)
+	})
+	for len(rest) > 0 && kept < max {
+		keep[rest[0]] = true
+		rest = rest[1:]
+		kept++
+	}
+
+	return keep, omittedSummary(usages, keep)
+}
+
+// sampleRealTime returns which processes the real-time check keeps: the ones
+// kept by the last run of the process check when it was sampled, or else a
+// sample rotating at the process check interval.
+func sampleRealTime(cfg *config.AgentConfig, usages []processUsage, now time.Time) ([]bool, *model.OmittedProcesses) {
+	max := cfg.ProcessSampleMax
+	if max <= 0 || len(usages) <= max {
+		return sampleProcesses(usages, max, cfg.ProcessSampleTopN, 0)
+	}
+	if sample := lastSample.get(); sample != nil {
+		keep := make([]bool, len(usages))
+		for i, u := range usages {
+			keep[i] = sample[sampleKey{u.pid, u.createTime}]
+		}
+		return keep, omittedSummary(usages, keep)
+	}
+	if interval := cfg.CheckIntervals["process"]; interval > 0 {
+		now = now.Truncate(interval)
+	}
+	return sampleProcesses(usages, max, cfg.ProcessSampleTopN, now.UnixNano())
+}
+
+// omittedSummary sums up the usage of the processes which aren't kept, nil when
+// all of them are.
+func omittedSummary(usages []processUsage, keep []bool) *model.OmittedProcesses {
+	var omitted *model.OmittedProcesses
+	for i, u := range usages {
+		if keep[i] {
+			continue
+		}
+		if omitted == nil {
+			omitted = &model.OmittedProcesses{}
+		}
+		omitted.Count++
+		omitted.TotalCpuPct += u.cpuPct
+		omitted.TotalRss += u.rss
+		omitted.TotalReadBytesRate += u.readBytes
+		omitted.TotalWriteBytesRate += u.writeBytes
+		omitted.TotalOpenFdCount += int64(u.fds)
+	}
+	return omitted
+}
+
+// sampleHash mixes the pid with the seed, see the finalizer of MurmurHash3.
+func sampleHash(pid int32, seed int64) uint64 {
+	h := uint64(pid) ^ uint64(seed)
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -1,13 +1,16 @@
 package checks
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/util/docker"
 	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
 	"github.com/DataDog/gopsutil/cpu"
 	"github.com/DataDog/gopsutil/process"
 	"github.com/stretchr/testify/assert"
@@ -90,7 +93,8 @@ func TestProcessChunking(t *testing.T) {
 			last[c.Pid] = c
 		}
 
-		chunked := fmtProcesses(cfg, cur, last, containers, syst2, syst1, lastRun)
+		formatted, usages := formatProcesses(cfg, cur, last, containers, syst2, syst1, lastRun)
+		chunked, _ := sampleProcessChunks(cfg, formatted, usages, lastRun)
 		assert.Len(t, chunked, tc.expectedChunks, "len %d", i)
 		total := 0
 		for _, c := range chunked {
@@ -98,7 +102,7 @@ func TestProcessChunking(t *testing.T) {
 		}
 		assert.Equal(t, tc.expectedTotal, total, "total test %d", i)
 
-		chunkedStat := fmtProcessStats(cfg, cur, last, containers, syst2, syst1, lastRun)
+		chunkedStat, _ := fmtProcessStats(cfg, cur, last, containers, syst2, syst1, lastRun)
 		assert.Len(t, chunkedStat, tc.expectedChunks, "len stat %d", i)
 		total = 0
 		for _, c := range chunkedStat {
@@ -109,6 +113,278 @@ func TestProcessChunking(t *testing.T) {
 	}
 }
 
+func TestProcessRules(t *testing.T) {
+	p := []*process.FilledProcess{
+		makeProcess(1, "git clone google.com"),
+		makeProcess(2, "mine-bitcoins -all -x"),
+		makeProcess(3, "datadog-process-agent -ddconfig datadog.conf"),
+		makeProcess(4, "nginx -g daemon off;"),
+		makeProcess(5, "foo -bar -bim"),
+	}
+	p[1].MemInfo.RSS = 100 * 1024 * 1024
+	containers := []*docker.Container{{ID: "abc", Image: "nginx:1.13", Pids: []int32{4}}}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.Blacklist = []*regexp.Regexp{regexp.MustCompile("^git")}
+	cfg.ProcessRules = []*config.ProcessRule{
+		{Name: "no-agent", Command: regexp.MustCompile("datadog")},
+		{Name: "big", Include: true, MinRSS: 50 * 1024 * 1024},
+		{Name: "nginx", Include: true, ContainerImage: regexp.MustCompile("^nginx")},
+	}
+	cur := make(map[int32]*process.FilledProcess)
+	for _, c := range p {
+		cur[c.Pid] = c
+	}
+	last := map[int32]*process.FilledProcess{1: p[0], 2: p[1], 3: p[2], 4: p[3]}
+
+	results := explainProcessRules(cfg, cur, last, containers, cpu.TimesStat{}, cpu.TimesStat{})
+	assert.Equal(t, []ProcessFilterResult{
+		{Pid: 1, Cmdline: p[0].Cmdline, Reason: "blacklisted"},
+		{Pid: 2, Cmdline: p[1].Cmdline, Collected: true, Reason: "included by rule big"},
+		{Pid: 3, Cmdline: p[2].Cmdline, Reason: "excluded by rule no-agent"},
+		{Pid: 4, Cmdline: p[3].Cmdline, Collected: true, Reason: "included by rule nginx"},
+		{Pid: 5, Cmdline: p[4].Cmdline, Reason: "not running at the previous collection"},
+	}, results)
+
+	// The collected processes are the ones included by the rules
+	lastRun := time.Now()
+	formatted, usages := formatProcesses(cfg, cur, last, containers, cpu.TimesStat{}, cpu.TimesStat{}, lastRun)
+	chunked, _ := sampleProcessChunks(cfg, formatted, usages, lastRun)
+	if assert.Len(t, chunked, 1) {
+		pids := make([]int32, 0, len(chunked[0]))
+		for _, proc := range chunked[0] {
+			pids = append(pids, proc.Pid)
+		}
+		assert.ElementsMatch(t, []int32{2, 4}, pids)
+	}
+}
+
+func TestProcessSampling(t *testing.T) {
+	usages := make([]processUsage, 0, 20)
+	for i := int32(1); i <= 20; i++ {
+		usages = append(usages, processUsage{pid: i, cpuPct: 1, rss: 10, fds: 1})
+	}
+	usages[3].cpuPct = 50
+	usages[7].rss = 1000
+	usages[11].readBytes = 100
+	usages[15].fds = 500
+
+	// Nothing is sampled below the maximum or when it's disabled
+	keep, omitted := sampleProcesses(usages, 20, 1, 0)
+	assert.Nil(t, omitted)
+	assert.NotContains(t, keep, false)
+	keep, omitted = sampleProcesses(usages, 0, 1, 0)
+	assert.Nil(t, omitted)
+	assert.NotContains(t, keep, false)
+
+	sampled := make(map[int32]bool)
+	for seed := int64(0); seed < 20; seed++ {
+		keep, omitted := sampleProcesses(usages, 8, 1, seed)
+		kept := 0
+		for i, k := range keep {
+			if k {
+				kept++
+				sampled[usages[i].pid] = true
+			}
+		}
+		assert.Equal(t, 8, kept)
+		// The top processes are always kept
+		for _, i := range []int{3, 7, 11, 15} {
+			assert.True(t, keep[i], "seed %d, pid %d", seed, usages[i].pid)
+		}
+		assert.Equal(t, &model.OmittedProcesses{Count: 12, TotalCpuPct: 12, TotalRss: 120, TotalOpenFdCount: 12}, omitted)
+	}
+	// The sample rotates over all the processes
+	assert.Len(t, sampled, 20)
+
+	// The top processes never exceed the maximum
+	for i := range usages {
+		usages[i].cpuPct = float32(i)
+		usages[i].rss = uint64(20 - i)
+		usages[i].readBytes = float32(i % 5)
+		usages[i].fds = int32(i % 7)
+	}
+	keep, omitted = sampleProcesses(usages, 6, 10, 0)
+	kept := 0
+	for _, k := range keep {
+		if k {
+			kept++
+		}
+	}
+	assert.Equal(t, 6, kept)
+	if assert.NotNil(t, omitted) {
+		assert.Equal(t, int32(14), omitted.Count)
+	}
+
+	// The summary is attached to the formatted processes
+	p := make(map[int32]*process.FilledProcess)
+	for i := int32(1); i <= 20; i++ {
+		p[i] = makeProcess(i, "sleep 10")
+		p[i].MemInfo.RSS = uint64(i)
+	}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessSampleMax = 8
+	cfg.ProcessSampleTopN = 2
+	lastRun := time.Now()
+	formatted, usages := formatProcesses(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, lastRun)
+	chunked, omitted := sampleProcessChunks(cfg, formatted, usages, lastRun)
+	if assert.Len(t, chunked, 1) && assert.Len(t, chunked[0], 8) && assert.NotNil(t, omitted) {
+		assert.Equal(t, int32(12), omitted.Count)
+		pids := make([]int32, 0, 8)
+		for _, proc := range chunked[0] {
+			pids = append(pids, proc.Pid)
+		}
+		assert.Subset(t, pids, []int32{19, 20})
+	}
+	chunkedStats, omitted := fmtProcessStats(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, time.Now())
+	if assert.Len(t, chunkedStats, 1) && assert.NotNil(t, omitted) {
+		assert.Len(t, chunkedStats[0], 8)
+		assert.Equal(t, int32(12), omitted.Count)
+	}
+}
+
+func TestRealTimeSampling(t *testing.T) {
+	p := make(map[int32]*process.FilledProcess)
+	for i := int32(1); i <= 20; i++ {
+		p[i] = makeProcess(i, "sleep 10")
+		p[i].MemInfo.RSS = uint64(i)
+	}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessSampleMax = 8
+	cfg.ProcessSampleTopN = 2
+	statPids := func(chunks [][]*model.ProcessStat) []int32 {
+		pids := make([]int32, 0, cfg.ProcessSampleMax)
+		for _, chunk := range chunks {
+			for _, s := range chunk {
+				pids = append(pids, s.Pid)
+			}
+		}
+		sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+		return pids
+	}
+
+	// Until the process check is sampled, the sample rotates at its interval
+	now := time.Now().Truncate(cfg.CheckIntervals["process"])
+	first, _ := fmtProcessStats(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, now)
+	second, _ := fmtProcessStats(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, now.Add(2*time.Second))
+	assert.Equal(t, statPids(first), statPids(second))
+
+	// The stats are then those of the processes the process check kept
+	lastRun := now.Add(time.Second)
+	formatted, usages := formatProcesses(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, lastRun)
+	chunked, omitted := sampleProcessChunks(cfg, formatted, usages, lastRun)
+	lastSample.set(chunked, omitted)
+	defer lastSample.set(nil, nil)
+	for i := 0; i < 3; i++ {
+		stats, omitted := fmtProcessStats(cfg, p, p, nil, cpu.TimesStat{}, cpu.TimesStat{}, now.Add(time.Duration(i)*2*time.Second))
+		assert.Equal(t, sortedPids(chunked[0]), statPids(stats))
+		if assert.NotNil(t, omitted) {
+			assert.Equal(t, int32(12), omitted.Count)
+		}
+	}
+}
+
+func TestSampledContainerRollups(t *testing.T) {
+	procs := make(map[int32]*process.FilledProcess)
+	containers := []*docker.Container{{ID: "foo"}, {ID: "bar"}, {ID: "bim"}}
+	for i := int32(1); i <= 30; i++ {
+		procs[i] = makeProcess(i, "sleep 10")
+		procs[i].MemInfo.RSS = uint64(i)
+		procs[i].NumThreads = 2
+		procs[i].OpenFdCount = 3
+		c := containers[i%3]
+		c.Pids = append(c.Pids, i)
+	}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.ProcessSampleMax = 6
+	cfg.ProcessSampleTopN = 1
+
+	// The sample rotates between runs, the rollups of all the processes don't
+	formatted, usages := formatProcesses(cfg, procs, procs, containers, cpu.TimesStat{}, cpu.TimesStat{}, time.Now())
+	samples := make(map[string]bool)
+	lastRun := time.Now()
+	for run := 0; run < 5; run++ {
+		lastRun = lastRun.Add(10 * time.Second)
+		chunked, omitted := sampleProcessChunks(cfg, formatted, usages, lastRun)
+		if assert.Len(t, chunked, 1) && assert.NotNil(t, omitted) {
+			assert.Len(t, chunked[0], 6)
+			assert.Equal(t, int32(24), omitted.Count)
+			samples[fmt.Sprint(sortedPids(chunked[0]))] = true
+		}
+
+		ctrs := [][]*model.Container{{{Id: "foo"}, {Id: "bar"}}, {{Id: "bim"}}}
+		rollupContainerProcesses([][]*model.Process{formatted}, ctrs, cfg.ContainerTopProcesses)
+		for _, chunk := range ctrs {
+			for _, c := range chunk {
+				assert.Equal(t, int32(10), c.ProcessCount, c.Id)
+				assert.Equal(t, int32(20), c.ThreadCount, c.Id)
+				assert.Equal(t, int32(30), c.OpenFdCount, c.Id)
+			}
+		}
+	}
+	assert.True(t, len(samples) > 1, "the sample didn't rotate")
+}
+
+func sortedPids(procs []*model.Process) []int32 {
+	pids := make([]int32, 0, len(procs))
+	for _, p := range procs {
+		pids = append(pids, p.Pid)
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids
+}
+
+func TestContainerProcessRollups(t *testing.T) {
+	makeProc := func(pid int32, ctrID, exe string, cpu float32, rss uint64, threads, fds int32) *model.Process {
+		return &model.Process{
+			Pid:         pid,
+			ContainerId: ctrID,
+			Command:     &model.Command{Exe: exe},
+			Cpu:         &model.CPUStat{TotalPct: cpu, NumThreads: threads},
+			Memory:      &model.MemoryStat{Rss: rss},
+			OpenFdCount: fds,
+		}
+	}
+	procs := [][]*model.Process{
+		{
+			makeProc(1, "foo", "/usr/bin/nginx", 10, 100, 4, 10),
+			makeProc(2, "foo", "/usr/bin/nginx", 50, 50, 2, -1),
+			makeProc(3, "", "/sbin/init", 90, 900, 1, 20),
+		},
+		{
+			makeProc(4, "foo", "/usr/bin/sidecar", 1, 500, 8, 5),
+			makeProc(5, "bar", "/bin/sh", 0, 10, 1, 3),
+		},
+	}
+	ctrs := [][]*model.Container{
+		{{Id: "foo"}},
+		{{Id: "bar"}, {Id: "bim"}},
+	}
+
+	rollupContainerProcesses(procs, ctrs, 2)
+
+	foo := ctrs[0][0]
+	assert.Equal(t, int32(3), foo.ProcessCount)
+	assert.Equal(t, int32(14), foo.ThreadCount)
+	assert.Equal(t, int32(15), foo.OpenFdCount)
+	assert.Len(t, foo.TopCpuProcesses, 2)
+	assert.Equal(t, int32(2), foo.TopCpuProcesses[0].Pid)
+	assert.Equal(t, int32(1), foo.TopCpuProcesses[1].Pid)
+	assert.Equal(t, "nginx", foo.TopCpuProcesses[0].Name)
+	assert.Len(t, foo.TopMemProcesses, 2)
+	assert.Equal(t, int32(4), foo.TopMemProcesses[0].Pid)
+	assert.Equal(t, uint64(500), foo.TopMemProcesses[0].MemRss)
+	assert.Equal(t, int32(1), foo.TopMemProcesses[1].Pid)
+
+	bar := ctrs[1][0]
+	assert.Equal(t, int32(1), bar.ProcessCount)
+	assert.Len(t, bar.TopCpuProcesses, 1)
+
+	bim := ctrs[1][1]
+	assert.Equal(t, int32(0), bim.ProcessCount)
+	assert.Nil(t, bim.TopCpuProcesses)
+	assert.Nil(t, bim.TopMemProcesses)
+}
+
 func TestPercentCalculation(t *testing.T) {
 	// Capping at NUM CPU * 100 if we get odd values for delta-{Proc,Time}
 	assert.True(t, floatEquals(calculatePct(100, 50, 1), 100))
@@ -4,14 +4,15 @@ import (
 	"github.com/DataDog/gopsutil/cpu"
 	"github.com/DataDog/gopsutil/host"
 	"github.com/DataDog/gopsutil/mem"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/DataDog/datadog-process-agent/config"
 	"github.com/DataDog/datadog-process-agent/model"
 )
 
-// CollectSystemInfo collects a set of system-level information that will not
-// change until a restart. This bit of information should be passed along with
-// the process messages.
+// CollectSystemInfo collects a set of system-level information that rarely
+// changes during the lifetime of the agent. This bit of information should be
+// passed along with the process messages.
 func CollectSystemInfo(cfg *config.AgentConfig) (*model.SystemInfo, error) {
 	hi, err := host.Info()
 	if err != nil {
@@ -53,3 +54,37 @@ func CollectSystemInfo(cfg *config.AgentConfig) (*model.SystemInfo, error) {
 		TotalMemory: int64(mi.Total),
 	}, nil
 }
+
+// RefreshSystemInfo collects the system-level information again and compares it
+// with cur. If anything changed (e.g. CPU hotplug, memory resize or a live kernel
+// patch) it returns the new information with an incremented Sequence and true,
+// otherwise cur is returned as-is.
+func RefreshSystemInfo(cfg *config.AgentConfig, cur *model.SystemInfo) (*model.SystemInfo, bool, error) {
+	info, err := CollectSystemInfo(cfg)
+	if err != nil {
+		return cur, false, err
+	}
+	if !systemInfoChanged(cur, info) {
+		return cur, false, nil
+	}
+	info.Sequence = cur.Sequence + 1
+	return info, true, nil
+}
+
+// systemInfoChanged compares two SystemInfo ignoring the Sequence and the CPU
+// frequencies, which fluctuate with frequency scaling.
+func systemInfoChanged(a, b *model.SystemInfo) bool {
+	return !proto.Equal(normalizeSystemInfo(a), normalizeSystemInfo(b))
+}
+
+func normalizeSystemInfo(info *model.SystemInfo) *model.SystemInfo {
+	n := *info
+	n.Sequence = 0
+	n.Cpus = make([]*model.CPUInfo, 0, len(info.Cpus))
+	for _, c := range info.Cpus {
+		ci := *c
+		ci.Mhz = 0
+		n.Cpus = append(n.Cpus, &ci)
+	}
+	return &n
+}
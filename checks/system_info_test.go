@@ -0,0 +1,48 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeSystemInfo(numCPUs int, mhz int64, totalMemory int64, kernel string) *model.SystemInfo {
+	cpus := make([]*model.CPUInfo, 0, numCPUs)
+	for i := 0; i < numCPUs; i++ {
+		cpus = append(cpus, &model.CPUInfo{Number: int32(i), Vendor: "GenuineIntel", Cores: 1, Mhz: mhz})
+	}
+	return &model.SystemInfo{
+		Uuid:        "host-uuid",
+		Os:          &model.OSInfo{Name: "linux", KernelVersion: kernel},
+		Cpus:        cpus,
+		TotalMemory: totalMemory,
+		Sequence:    3,
+	}
+}
+
+func TestSystemInfoChanged(t *testing.T) {
+	base := makeSystemInfo(2, 2400, 1<<30, "4.4.0-112-generic")
+
+	for i, tc := range []struct {
+		info     *model.SystemInfo
+		expected bool
+	}{
+		{makeSystemInfo(2, 2400, 1<<30, "4.4.0-112-generic"), false},
+		// CPU frequencies change with frequency scaling and are ignored.
+		{makeSystemInfo(2, 1200, 1<<30, "4.4.0-112-generic"), false},
+		// CPU hotplug
+		{makeSystemInfo(4, 2400, 1<<30, "4.4.0-112-generic"), true},
+		// Memory resize
+		{makeSystemInfo(2, 2400, 2<<30, "4.4.0-112-generic"), true},
+		// Live kernel patch
+		{makeSystemInfo(2, 2400, 1<<30, "4.4.0-116-generic"), true},
+	} {
+		tc.info.Sequence = 0
+		assert.Equal(t, tc.expected, systemInfoChanged(base, tc.info), "case %d", i)
+	}
+
+	// Normalizing must not modify the compared values.
+	assert.Equal(t, int64(2400), base.Cpus[0].Mhz)
+	assert.Equal(t, int64(3), base.Sequence)
+}
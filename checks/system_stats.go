@@ -0,0 +1,192 @@
+package checks
+
+import (
+	"time"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/load"
+	"github.com/DataDog/gopsutil/mem"
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// SystemStats is a singleton SystemStatsCheck running on the normal schedule.
+var SystemStats = &SystemStatsCheck{name: "system"}
+
+// RTSystemStats is a singleton SystemStatsCheck running on the real-time schedule.
+var RTSystemStats = &SystemStatsCheck{name: "rtsystem", realTime: true}
+
+// SystemStatsCheck collects host-level load, CPU, memory, process and
+// file-descriptor statistics. The instance stores the previous snapshot between
+// checks for calculation of rates and CPU percentages.
+type SystemStatsCheck struct {
+	name     string
+	realTime bool
+	last     *systemSnapshot
+}
+
+// systemSnapshot holds the raw host counters collected during a single run.
+type systemSnapshot struct {
+	load   *load.AvgStat
+	cpu    cpu.TimesStat
+	mem    *mem.VirtualMemoryStat
+	swap   *mem.SwapMemoryStat
+	procfs procfsStats
+	time   time.Time
+}
+
+// procfsStats are the host counters which are not exposed by gopsutil.
+type procfsStats struct {
+	ctxSwitches uint64
+	forks       uint64
+
+	procsTotal    int32
+	procsRunning  int32
+	procsSleeping int32
+	procsBlocked  int32
+	procsStopped  int32
+	procsZombie   int32
+	procsIdle     int32
+	threadsTotal  int32
+
+	openFds uint64
+	maxFds  uint64
+}
+
+// Init initializes a SystemStatsCheck instance.
+func (s *SystemStatsCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {}
+
+// Name returns the name of the SystemStatsCheck.
+func (s *SystemStatsCheck) Name() string { return s.name }
+
+// Endpoint returns the endpoint where this check is submitted.
+func (s *SystemStatsCheck) Endpoint() string { return "/api/v1/collector" }
+
+// RealTime indicates if this check only runs in real-time mode.
+func (s *SystemStatsCheck) RealTime() bool { return s.realTime }
+
+// Run runs the SystemStatsCheck to collect host-level statistics. Load averages,
+// CPU times and memory come from `gopsutil`, the rest is read out of procfs
+// where it's available.
+func (s *SystemStatsCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	cur, err := collectSystemSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	// End check early if this is our first run.
+	if s.last == nil {
+		s.last = cur
+		return nil, nil
+	}
+
+	stats := fmtSystemStats(cur, s.last)
+	s.last = cur
+
+	return []model.MessageBody{&model.CollectorSystemStats{
+		HostName:  cfg.HostName,
+		Stats:     stats,
+		GroupId:   groupID,
+		GroupSize: 1,
+	}}, nil
+}
+
+func collectSystemSnapshot() (*systemSnapshot, error) {
+	cpuTimes, err := cpu.Times(false)
+	if err != nil {
+		return nil, err
+	}
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return nil, err
+	}
+	swap, err := mem.SwapMemory()
+	if err != nil {
+		return nil, err
+	}
+	// Load averages don't exist on every platform, we'll report them as zero.
+	avg, err := load.Avg()
+	if err != nil {
+		avg = &load.AvgStat{}
+	}
+	procfs, err := readProcfsStats()
+	if err != nil {
+		log.Debugf("could not read system stats from procfs: %s", err)
+	}
+
+	return &systemSnapshot{
+		load:   avg,
+		cpu:    cpuTimes[0],
+		mem:    vm,
+		swap:   swap,
+		procfs: procfs,
+		time:   time.Now(),
+	}, nil
+}
+
+// fmtSystemStats converts two consecutive snapshots into a SystemStats.
+func fmtSystemStats(cur, last *systemSnapshot) *model.SystemStats {
+	elapsed := cur.time.Sub(last.time).Seconds()
+	rate := func(cur, prev uint64) float32 {
+		if elapsed <= 0 || cur < prev {
+			return 0
+		}
+		return float32(float64(cur-prev) / elapsed)
+	}
+
+	c2, c1 := cur.cpu, last.cpu
+	total := c2.Total() - c1.Total()
+	pct := func(cur, prev float64) float32 {
+		if total <= 0 {
+			return 0
+		}
+		return float32((cur - prev) / total * 100)
+	}
+
+	return &model.SystemStats{
+		Load1:  float32(cur.load.Load1),
+		Load5:  float32(cur.load.Load5),
+		Load15: float32(cur.load.Load15),
+
+		UserPct:    pct(c2.User, c1.User),
+		SystemPct:  pct(c2.System, c1.System),
+		IdlePct:    pct(c2.Idle, c1.Idle),
+		NicePct:    pct(c2.Nice, c1.Nice),
+		IowaitPct:  pct(c2.Iowait, c1.Iowait),
+		IrqPct:     pct(c2.Irq, c1.Irq),
+		SoftirqPct: pct(c2.Softirq, c1.Softirq),
+		StealPct:   pct(c2.Steal, c1.Steal),
+		GuestPct:   pct(c2.Guest, c1.Guest),
+
+		MemTotal:     cur.mem.Total,
+		MemAvailable: cur.mem.Available,
+		MemUsed:      cur.mem.Used,
+		MemFree:      cur.mem.Free,
+		MemBuffers:   cur.mem.Buffers,
+		MemCached:    cur.mem.Cached,
+		MemShared:    cur.mem.Shared,
+		MemSlab:      cur.mem.Slab,
+		SwapTotal:    cur.swap.Total,
+		SwapUsed:     cur.swap.Used,
+		SwapFree:     cur.swap.Free,
+		SwapInBps:    rate(cur.swap.Sin, last.swap.Sin),
+		SwapOutBps:   rate(cur.swap.Sout, last.swap.Sout),
+
+		CtxSwitchesPs: rate(cur.procfs.ctxSwitches, last.procfs.ctxSwitches),
+		ForksPs:       rate(cur.procfs.forks, last.procfs.forks),
+
+		ProcsTotal:    cur.procfs.procsTotal,
+		ProcsRunning:  cur.procfs.procsRunning,
+		ProcsSleeping: cur.procfs.procsSleeping,
+		ProcsBlocked:  cur.procfs.procsBlocked,
+		ProcsStopped:  cur.procfs.procsStopped,
+		ProcsZombie:   cur.procfs.procsZombie,
+		ProcsIdle:     cur.procfs.procsIdle,
+		ThreadsTotal:  cur.procfs.threadsTotal,
+
+		OpenFds: cur.procfs.openFds,
+		MaxFds:  cur.procfs.maxFds,
+	}
+}
@@ -0,0 +1,97 @@
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readProcfsStats reads the context switch and fork counters from /proc/stat,
+// the file-descriptor usage from /proc/sys/fs/file-nr and counts the processes
+// by state out of /proc/<pid>/stat.
+func readProcfsStats() (procfsStats, error) {
+	var s procfsStats
+
+	lines, err := util.ReadLines(util.HostProc("stat"))
+	if err != nil {
+		return s, err
+	}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "ctxt":
+			s.ctxSwitches, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "processes":
+			s.forks, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	// file-nr is "<allocated> <allocated but unused> <max>"
+	if data, err := ioutil.ReadFile(util.HostProc("sys", "fs", "file-nr")); err == nil {
+		fields := strings.Fields(string(data))
+		if len(fields) == 3 {
+			allocated, _ := strconv.ParseUint(fields[0], 10, 64)
+			unused, _ := strconv.ParseUint(fields[1], 10, 64)
+			s.openFds = allocated - unused
+			s.maxFds, _ = strconv.ParseUint(fields[2], 10, 64)
+		}
+	}
+
+	d, err := os.Open(util.HostProc())
+	if err != nil {
+		return s, err
+	}
+	defer d.Close()
+	names, err := d.Readdirnames(-1)
+	if err != nil {
+		return s, err
+	}
+	for _, name := range names {
+		if _, err := strconv.Atoi(name); err != nil {
+			continue
+		}
+		data, err := ioutil.ReadFile(util.HostProc(name, "stat"))
+		if err != nil {
+			// The process exited since we listed the directory.
+			continue
+		}
+		// The command name can contain spaces and parentheses so we look for
+		// the state right after the last closing parenthesis, e.g.
+		//
+		// 	1 (systemd) S 0 1 1 0 -1 4194560 ...
+		stat := string(data)
+		i := strings.LastIndex(stat, ")")
+		if i < 0 {
+			continue
+		}
+		fields := strings.Fields(stat[i+1:])
+		if len(fields) < 18 {
+			continue
+		}
+		s.procsTotal++
+		switch fields[0] {
+		case "R":
+			s.procsRunning++
+		case "S":
+			s.procsSleeping++
+		case "D":
+			s.procsBlocked++
+		case "T", "t":
+			s.procsStopped++
+		case "Z":
+			s.procsZombie++
+		case "I":
+			s.procsIdle++
+		}
+		if threads, err := strconv.Atoi(fields[17]); err == nil {
+			s.threadsTotal += int32(threads)
+		}
+	}
+	return s, nil
+}
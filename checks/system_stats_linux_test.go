@@ -0,0 +1,54 @@
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadProcfsStats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "procfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"stat":           "cpu  1 2 3 4 5 6 7 8 9 10\nctxt 123456\nbtime 1519898400\nprocesses 789\nprocs_running 2\n",
+		"sys/fs/file-nr": "2048\t48\t65536\n",
+		"1/stat":         "1 (systemd) S 0 1 1 0 -1 4194560 1 2 3 4 5 6 7 8 20 0 1 0 9 10 11\n",
+		"20/stat":        "20 (my (weird) cmd) R 1 20 20 0 -1 4194560 1 2 3 4 5 6 7 8 20 0 4 0 9 10 11\n",
+		"30/stat":        "30 (zombie) Z 1 30 30 0 -1 4194560 1 2 3 4 5 6 7 8 20 0 1 0 9 10 11\n",
+		"40/stat":        "40 (kworker/0:0) I 2 0 0 0 -1 4194560 1 2 3 4 5 6 7 8 20 0 1 0 9 10 11\n",
+		"self/stat":      "1 (systemd) S 0 1 1 0 -1 4194560 1 2 3 4 5 6 7 8 20 0 1 0 9 10 11\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	os.Setenv("HOST_PROC", dir)
+	defer os.Unsetenv("HOST_PROC")
+
+	s, err := readProcfsStats()
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.Equal(uint64(123456), s.ctxSwitches)
+	assert.Equal(uint64(789), s.forks)
+	assert.Equal(uint64(2000), s.openFds)
+	assert.Equal(uint64(65536), s.maxFds)
+	assert.Equal(int32(4), s.procsTotal)
+	assert.Equal(int32(1), s.procsRunning)
+	assert.Equal(int32(1), s.procsSleeping)
+	assert.Equal(int32(1), s.procsZombie)
+	assert.Equal(int32(1), s.procsIdle)
+	assert.Equal(int32(7), s.threadsTotal)
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readProcfsStats is only implemented on Linux, other platforms report zeroes for
+// the counters we read out of procfs.
+func readProcfsStats() (procfsStats, error) {
+	return procfsStats{}, util.ErrNotImplemented
+}
@@ -0,0 +1,75 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/load"
+	"github.com/DataDog/gopsutil/mem"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFmtSystemStats(t *testing.T) {
+	now := time.Now()
+	last := &systemSnapshot{
+		load:   &load.AvgStat{},
+		cpu:    cpu.TimesStat{User: 100, System: 50, Idle: 800, Iowait: 50},
+		mem:    &mem.VirtualMemoryStat{},
+		swap:   &mem.SwapMemoryStat{Sin: 1000, Sout: 2000},
+		procfs: procfsStats{ctxSwitches: 10000, forks: 500},
+		time:   now.Add(-10 * time.Second),
+	}
+	cur := &systemSnapshot{
+		load: &load.AvgStat{Load1: 1.5, Load5: 1, Load15: 0.5},
+		cpu:  cpu.TimesStat{User: 130, System: 60, Idle: 850, Iowait: 60},
+		mem: &mem.VirtualMemoryStat{
+			Total:     8000,
+			Available: 6000,
+			Used:      2000,
+			Free:      3000,
+			Buffers:   500,
+			Cached:    2500,
+		},
+		swap: &mem.SwapMemoryStat{Total: 1000, Used: 100, Free: 900, Sin: 11000, Sout: 2000},
+		procfs: procfsStats{
+			ctxSwitches:   60000,
+			forks:         600,
+			procsTotal:    5,
+			procsRunning:  1,
+			procsSleeping: 3,
+			procsZombie:   1,
+			threadsTotal:  12,
+			openFds:       1024,
+			maxFds:        65536,
+		},
+		time: now,
+	}
+
+	s := fmtSystemStats(cur, last)
+	assert := assert.New(t)
+	assert.Equal(float32(1.5), s.Load1)
+	assert.Equal(float32(0.5), s.Load15)
+	assert.Equal(float32(30), s.UserPct)
+	assert.Equal(float32(10), s.SystemPct)
+	assert.Equal(float32(50), s.IdlePct)
+	assert.Equal(float32(10), s.IowaitPct)
+	assert.Equal(uint64(6000), s.MemAvailable)
+	assert.Equal(uint64(2500), s.MemCached)
+	assert.Equal(uint64(100), s.SwapUsed)
+	assert.Equal(float32(1000), s.SwapInBps)
+	assert.Equal(float32(0), s.SwapOutBps)
+	assert.Equal(float32(5000), s.CtxSwitchesPs)
+	assert.Equal(float32(10), s.ForksPs)
+	assert.Equal(int32(5), s.ProcsTotal)
+	assert.Equal(int32(3), s.ProcsSleeping)
+	assert.Equal(int32(1), s.ProcsZombie)
+	assert.Equal(int32(12), s.ThreadsTotal)
+	assert.Equal(uint64(1024), s.OpenFds)
+	assert.Equal(uint64(65536), s.MaxFds)
+
+	// Counters going backwards (e.g. after a wrap) must not produce huge rates.
+	s = fmtSystemStats(last, cur)
+	assert.Equal(float32(0), s.CtxSwitchesPs)
+	assert.Equal(float32(0), s.UserPct)
+}
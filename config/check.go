@@ -0,0 +1,682 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// The sources of the configuration, by increasing precedence.
+const (
+	SourceDefault = "default"
+	SourceIni     = "ini"
+	SourceYaml    = "yaml"
+	SourceEnv     = "env"
+)
+
+// envSource is the file reported for the problems found in the environment.
+const envSource = "environment"
+
+// Problem is an invalid or unknown configuration key.
+type Problem struct {
+	// The path of the file, or "environment".
+	File string
+	// The line of the key in the file, 0 when unknown.
+	Line    int
+	Key     string
+	Message string
+}
+
+func (p Problem) String() string {
+	loc := p.File
+	if p.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", p.File, p.Line)
+	}
+	if p.Key == "" {
+		return fmt.Sprintf("%s: %s", loc, p.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", loc, p.Key, p.Message)
+}
+
+// Field is an AgentConfig field with its effective value, secrets redacted, and
+// the source which set it.
+type Field struct {
+	Name   string
+	Value  string
+	Source string
+}
+
+// CheckResult is the outcome of CheckConfig.
+type CheckResult struct {
+	Problems []Problem
+	Fields   []Field
+}
+
+// valueCheck returns an error when a raw config value is invalid.
+type valueCheck func(string) error
+
+// iniChecks are the keys of the [process.config] section. The <check>_interval
+// keys are added from the default check intervals.
+var iniChecks = map[string]valueCheck{
+	"endpoint":                   checkURL,
+	"queue_size":                 checkInt,
+	"max_proc_fds":               checkInt,
+	"allow_real_time":            checkIniBool,
+	"log_file":                   nil,
+	"dd_agent_py":                nil,
+	"dd_agent_py_env":            nil,
+	"blacklist":                  checkPatterns,
+	"scrub_args":                 checkIniBool,
+	"custom_sensitive_words":     nil,
+	"scrub_patterns":             checkPatterns,
+	"scrub_positional_args":      checkScrubPositionalArgs,
+	"scrub_url_credentials":      checkIniBool,
+	"scrub_structured_args":      checkIniBool,
+	"scrub_hash_values":          checkIniBool,
+	"scrub_hash_key":             nil,
+	"proc_limit":                 checkProcLimit,
+	"max_message_bytes":          checkInt,
+	"process_sample_max":         checkInt,
+	"process_sample_top_n":       checkInt,
+	"process_delta":              checkIniBool,
+	"process_delta_resync":       checkInt,
+	"prometheus_listen_addr":     nil,
+	"prometheus_process_labels":  nil,
+	"prometheus_process_include": checkPatterns,
+	"prometheus_process_exclude": checkPatterns,
+	"prometheus_max_processes":   checkInt,
+	"otlp_endpoint":              checkURL,
+	"otlp_only":                  checkIniBool,
+	"payload_sinks":              checkPayloadSinks,
+	"file_sink_dir":              nil,
+	"file_sink_format":           checkFileSinkFormat,
+	"file_sink_max_size":         checkInt,
+	"file_sink_max_files":        checkInt,
+	"system_info_interval":       checkInt,
+	"collect_system_stats":       checkIniBool,
+	"collect_docker_network":     checkIniBool,
+	"container_blacklist":        nil,
+	"container_whitelist":        nil,
+	"container_label_whitelist":  nil,
+	"container_top_processes":    checkInt,
+	"container_cache_duration":   checkInt,
+}
+
+// iniMainChecks are the keys of the [Main] section read by the process-agent.
+// The section is shared with the dd-agent so other keys aren't reported.
+var iniMainChecks = map[string]valueCheck{
+	"process_agent_enabled":          nil,
+	"dogstatsd_port":                 checkInt,
+	"secret_backend_command":         nil,
+	"secret_backend_arguments":       nil,
+	"secret_backend_timeout":         checkInt,
+	"secret_backend_output_max_size": checkInt,
+}
+
+// envChecks are the environment variables whose value is parsed, besides the
+// ones of the process_config fields.
+var envChecks = map[string]valueCheck{
+	"DD_DOGSTATSD_PORT":                 checkInt,
+	"DD_SECRET_BACKEND_TIMEOUT":         checkInt,
+	"DD_SECRET_BACKEND_OUTPUT_MAX_SIZE": checkInt,
+}
+
+// CheckConfig validates the dd-agent config at iniPath, the datadog.yaml at
+// yamlPath and the environment, either file being skipped when it doesn't
+// exist. It reports every invalid or unknown key and the fields of the
+// effective AgentConfig with the source which set them, before the hostname
+// is resolved.
+func CheckConfig(iniPath, yamlPath string) (*CheckResult, error) {
+	var res CheckResult
+	defaults := NewDefaultAgentConfig()
+	intervalKeys := make([]string, 0, len(defaults.CheckIntervals))
+	for name := range defaults.CheckIntervals {
+		intervalKeys = append(intervalKeys, name+"_interval")
+	}
+
+	agentIni, err := NewIfExists(iniPath)
+	if err != nil {
+		res.Problems = append(res.Problems, Problem{File: iniPath, Message: err.Error()})
+		agentIni = nil
+	}
+	if agentIni != nil {
+		res.Problems = append(res.Problems, validateIni(iniPath, intervalKeys)...)
+	}
+
+	var agentYaml *YamlAgentConfig
+	if util.PathExists(yamlPath) {
+		problems, ok := validateYaml(yamlPath)
+		res.Problems = append(res.Problems, problems...)
+		if ok {
+			if agentYaml, err = NewYamlIfExists(yamlPath); err != nil {
+				return nil, err
+			}
+			if err := SetupDDAgentConfig(yamlPath); err != nil {
+				res.Problems = append(res.Problems, Problem{File: yamlPath, Message: err.Error()})
+			}
+		}
+	}
+
+	// The secrets are decrypted once, each stage reusing the decrypted files.
+	secrets, err := newSecretBackend(agentIni, agentYaml)
+	if err != nil {
+		res.Problems = append(res.Problems, Problem{File: envSource, Message: err.Error()})
+	}
+	if err := secrets.decryptFiles(agentIni, nil); err != nil {
+		res.Problems = append(res.Problems, Problem{File: iniPath, Message: err.Error()})
+		agentIni = nil
+	}
+	if err := secrets.decryptFiles(nil, agentYaml); err != nil {
+		res.Problems = append(res.Problems, Problem{File: yamlPath, Message: err.Error()})
+		agentYaml = nil
+	}
+	getenv := func(name string) string {
+		v, err := secrets.decryptEnv(name)
+		if err != nil {
+			res.Problems = append(res.Problems, Problem{File: envSource, Key: name, Message: err.Error()})
+		}
+		return v
+	}
+	res.Problems = append(res.Problems, validateEnv(getenv)...)
+
+	// Apply the sources one after the other in a single pass, each field coming
+	// from the last source which changed it. A merge failing halfway still keeps
+	// the fields it changed, as the merges modify the config in place.
+	stages := []struct {
+		source string
+		file   string
+		merge  func(*AgentConfig) (*AgentConfig, error)
+	}{
+		{SourceIni, iniPath, func(c *AgentConfig) (*AgentConfig, error) {
+			if agentIni == nil {
+				return c, nil
+			}
+			return mergeIniConfig(c, agentIni)
+		}},
+		{SourceYaml, yamlPath, func(c *AgentConfig) (*AgentConfig, error) {
+			if agentYaml == nil {
+				return c, nil
+			}
+			return mergeYamlConfig(c, agentYaml)
+		}},
+		{SourceEnv, envSource, func(c *AgentConfig) (*AgentConfig, error) {
+			return mergeEnv(c), nil
+		}},
+	}
+	fields := configFields(defaults)
+	for i := range fields {
+		fields[i].Source = SourceDefault
+	}
+	cfg := defaults
+	cfg.secrets = secrets
+	for _, stage := range stages {
+		if merged, err := stage.merge(cfg); err != nil {
+			if !reported(res.Problems, stage.file, err) {
+				res.Problems = append(res.Problems, Problem{File: stage.file, Message: err.Error()})
+			}
+		} else {
+			cfg = merged
+		}
+		for j, f := range configFields(cfg) {
+			if f.Value != fields[j].Value {
+				fields[j] = Field{Name: f.Name, Value: f.Value, Source: stage.source}
+			}
+		}
+	}
+	res.Fields = fields
+	return &res, nil
+}
+
+// reported returns whether the error of a merge was already reported by the
+// validation of the file.
+func reported(problems []Problem, file string, err error) bool {
+	for _, p := range problems {
+		if p.File == file && strings.Contains(err.Error(), p.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateIni reports the unknown and invalid keys of the process-agent sections.
+func validateIni(path string, intervalKeys []string) []Problem {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return []Problem{{File: path, Message: err.Error()}}
+	}
+	checks := make(map[string]valueCheck, len(iniChecks)+len(intervalKeys))
+	for k, c := range iniChecks {
+		checks[k] = c
+	}
+	for _, k := range intervalKeys {
+		checks[k] = checkInt
+	}
+
+	var problems []Problem
+	section := ""
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if line[0] == '[' && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		sep := strings.IndexAny(line, "=:")
+		if sep < 0 {
+			continue
+		}
+		key, value := strings.TrimSpace(line[:sep]), strings.TrimSpace(line[sep+1:])
+		var check valueCheck
+		switch section {
+		case "Main":
+			check = iniMainChecks[key]
+		case "process.config":
+			var known bool
+			if check, known = checks[key]; !known {
+				problems = append(problems, Problem{File: path, Line: i + 1, Key: key, Message: "unknown key"})
+				continue
+			}
+		default:
+			continue
+		}
+		// The secrets are checked once decrypted, when the config is merged.
+		if _, secret := secretHandle(value); check != nil && !secret {
+			if err := check(value); err != nil {
+				problems = append(problems, Problem{File: path, Line: i + 1, Key: key, Message: err.Error()})
+			}
+		}
+	}
+	return problems
+}
+
+var yamlLineError = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// validateYaml reports the unknown and invalid keys of the process_config
+// section. It returns false when the file can't be parsed at all.
+func validateYaml(path string) ([]Problem, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return []Problem{{File: path, Message: err.Error()}}, false
+	}
+	var problems []Problem
+	addError := func(msg string) {
+		p := Problem{File: path, Message: msg}
+		if m := yamlLineError.FindStringSubmatch(msg); m != nil {
+			p.Line, _ = strconv.Atoi(m[1])
+			p.Message = m[2]
+		}
+		problems = append(problems, p)
+	}
+
+	var raw map[interface{}]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		addError(err.Error())
+		return problems, false
+	}
+	var yc YamlAgentConfig
+	if err := yaml.Unmarshal(data, &yc); err != nil {
+		if terr, ok := err.(*yaml.TypeError); ok {
+			for _, msg := range terr.Errors {
+				addError(msg)
+			}
+		} else {
+			addError(err.Error())
+		}
+		return problems, false
+	}
+
+	lines := yamlKeyLines(data)
+	add := func(key, msg string) {
+		problems = append(problems, Problem{File: path, Line: lines[key], Key: key, Message: msg})
+	}
+	known := yamlKeys(reflect.TypeOf(yc.Process), "process_config")
+	if section, ok := raw["process_config"].(map[interface{}]interface{}); ok {
+		var unknown []string
+		walkYamlKeys(section, "process_config", known, &unknown)
+		sort.Strings(unknown)
+		for _, k := range unknown {
+			add(k, "unknown key")
+		}
+	}
+
+	for _, p := range checkProcessConfig(&yc.Process) {
+		add(p.Key, p.Message)
+	}
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].Line < problems[j].Line })
+	return problems, true
+}
+
+// checkProcessConfig reports the invalid values of a process_config section, by
+// key under process_config.
+func checkProcessConfig(p *ProcessConfig) []Problem {
+	var problems []Problem
+	add := func(key, msg string) {
+		problems = append(problems, Problem{Key: "process_config." + key, Message: msg})
+	}
+	// The secrets are checked once decrypted, when the config is merged.
+	plain := func(v string) bool {
+		_, secret := secretHandle(v)
+		return v != "" && !secret
+	}
+	switch strings.ToLower(p.Enabled) {
+	case "", "true", "yes", "1", "false", "no", "0", "disabled":
+	default:
+		add("enabled", fmt.Sprintf("invalid value '%s', expected true, false or disabled", p.Enabled))
+	}
+	if plain(p.ProcessDDURL) {
+		if err := checkURL(p.ProcessDDURL); err != nil {
+			add("process_dd_url", err.Error())
+		}
+	}
+	if plain(p.OTLPEndpoint) {
+		if err := checkURL(p.OTLPEndpoint); err != nil {
+			add("otlp_endpoint", err.Error())
+		}
+	}
+	for key, patterns := range map[string][]string{
+		"blacklist_patterns":         p.BlacklistPatterns,
+		"prometheus_process_include": p.PrometheusProcessInclude,
+		"prometheus_process_exclude": p.PrometheusProcessExclude,
+		"scrub_patterns":             p.ScrubPatterns,
+	} {
+		for _, pat := range patterns {
+			if !plain(pat) {
+				continue
+			}
+			if _, err := regexp.Compile(pat); err != nil {
+				add(key, fmt.Sprintf("invalid pattern: %s", err))
+			}
+		}
+	}
+	for exe, positions := range p.ScrubPositionalArgs {
+		for _, pos := range positions {
+			if pos < 1 {
+				add("scrub_positional_args."+exe, fmt.Sprintf("invalid argument position %d, expected a positive integer", pos))
+			}
+		}
+	}
+	for i, rule := range p.ProcessRules {
+		if _, err := compileProcessRule(rule); err != nil {
+			add(fmt.Sprintf("process_rules[%d]", i), err.Error())
+		}
+	}
+	for endpoint, names := range p.PayloadSinks {
+		if _, err := validSinks(names); err != nil {
+			add("payload_sinks."+endpoint, err.Error())
+		}
+	}
+	if plain(p.FileSinkFormat) {
+		if err := checkFileSinkFormat(p.FileSinkFormat); err != nil {
+			add("file_sink_format", err.Error())
+		}
+	}
+	if p.MaxMessageBytes < 0 {
+		add("max_message_bytes", "must not be negative")
+	}
+	if p.ProcessSampleMax < 0 {
+		add("process_sample_max", "must not be negative")
+	}
+	if p.ProcessSampleTopN < 0 {
+		add("process_sample_top_n", "must not be negative")
+	}
+	if p.ProcessSampleMax > 0 {
+		topN := p.ProcessSampleTopN
+		if topN == 0 {
+			topN = defaultProcessSampleTopN
+		}
+		if topN*4 > p.ProcessSampleMax {
+			add("process_sample_top_n", fmt.Sprintf("%d top processes for each of the 4 usages exceed process_sample_max", topN))
+		}
+	}
+	if p.ProcessDeltaResync < 0 {
+		add("process_delta_resync", "must not be negative")
+	}
+	if p.MaxPerMessage > MaxProcLimit {
+		add("max_per_message", fmt.Sprintf("exceeds the maximum of %d", MaxProcLimit))
+	}
+	return problems
+}
+
+// yamlKeys returns the dotted paths of the YAML keys of a struct type. Map
+// fields accept any key and are returned with a trailing ".*".
+func yamlKeys(t reflect.Type, prefix string) map[string]bool {
+	keys := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		path := prefix + "." + name
+		keys[path] = true
+		switch f.Type.Kind() {
+		case reflect.Struct:
+			for k := range yamlKeys(f.Type, path) {
+				keys[k] = true
+			}
+		case reflect.Map:
+			keys[path+".*"] = true
+		}
+	}
+	return keys
+}
+
+func walkYamlKeys(m map[interface{}]interface{}, prefix string, known map[string]bool, unknown *[]string) {
+	if known[prefix+".*"] {
+		return
+	}
+	for k, v := range m {
+		path := fmt.Sprintf("%s.%v", prefix, k)
+		if !known[path] {
+			*unknown = append(*unknown, path)
+			continue
+		}
+		if sub, ok := v.(map[interface{}]interface{}); ok {
+			walkYamlKeys(sub, path, known, unknown)
+		}
+	}
+}
+
+var yamlKeyLine = regexp.MustCompile(`^(\s*)("[^"]*"|'[^']*'|[^\s#"'-][^:#]*?)\s*:(\s|$)`)
+
+// yamlKeyLines returns the line of each key of a block-style YAML document
+// by dotted path. Keys in sequences aren't indexed.
+func yamlKeyLines(data []byte) map[string]int {
+	type entry struct {
+		indent int
+		key    string
+	}
+	lines := make(map[string]int)
+	var stack []entry
+	for i, line := range strings.Split(string(data), "\n") {
+		m := yamlKeyLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		indent, key := len(m[1]), strings.Trim(m[2], `"'`)
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		stack = append(stack, entry{indent, key})
+		parts := make([]string, len(stack))
+		for j, e := range stack {
+			parts[j] = e.key
+		}
+		lines[strings.Join(parts, ".")] = i + 1
+	}
+	return lines
+}
+
+// validateEnv reports the environment variables with an invalid value.
+func validateEnv(getenv func(string) string) []Problem {
+	_, problems := processConfigFromEnv(getenv)
+	for name, check := range envChecks {
+		if v := getenv(name); v != "" {
+			if err := check(v); err != nil {
+				problems = append(problems, Problem{File: envSource, Key: name, Message: err.Error()})
+			}
+		}
+	}
+	sort.Slice(problems, func(i, j int) bool { return problems[i].Key < problems[j].Key })
+	return problems
+}
+
+func checkInt(v string) error {
+	if _, err := strconv.Atoi(strings.TrimSpace(v)); err != nil {
+		return fmt.Errorf("invalid number '%s'", v)
+	}
+	return nil
+}
+
+func checkProcLimit(v string) error {
+	if err := checkInt(v); err != nil {
+		return err
+	}
+	if n, _ := strconv.Atoi(strings.TrimSpace(v)); n > MaxProcLimit {
+		return fmt.Errorf("exceeds the maximum of %d", MaxProcLimit)
+	}
+	return nil
+}
+
+// checkIniBool accepts the booleans of File.GetBool.
+func checkIniBool(v string) error {
+	_, err := parseBool(v)
+	return err
+}
+
+func checkURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %s", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid URL '%s', expected scheme://host", v)
+	}
+	return nil
+}
+
+func checkPatterns(v string) error {
+	for _, p := range strings.Split(v, ",") {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid pattern: %s", err)
+		}
+	}
+	return nil
+}
+
+func checkPayloadSinks(v string) error {
+	_, err := parsePayloadSinks(v)
+	return err
+}
+
+func checkScrubPositionalArgs(v string) error {
+	_, err := parseScrubPositionalArgs(v)
+	return err
+}
+
+func checkFileSinkFormat(v string) error {
+	if v != "binary" && v != "json" {
+		return fmt.Errorf("invalid format '%s', expected binary or json", v)
+	}
+	return nil
+}
+
+// configFields returns the exported fields of the config, formatted with the
+// secrets redacted.
+func configFields(cfg *AgentConfig) []Field {
+	redacted := cfg.Redacted()
+	v := reflect.ValueOf(&redacted).Elem()
+	t := v.Type()
+	fields := make([]Field, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Tag.Get("json") == "-" {
+			continue
+		}
+		fields = append(fields, Field{Name: f.Name, Value: formatField(v.Field(i).Interface())})
+	}
+	return fields
+}
+
+func formatField(v interface{}) string {
+	switch x := v.(type) {
+	case *url.URL:
+		if x == nil {
+			return ""
+		}
+		u := *x
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), "********")
+		}
+		return u.String()
+	case []*regexp.Regexp:
+		patterns := make([]string, 0, len(x))
+		for _, r := range x {
+			patterns = append(patterns, r.String())
+		}
+		return "[" + strings.Join(patterns, ", ") + "]"
+	case []*ProcessRule:
+		rules := make([]string, 0, len(x))
+		for _, r := range x {
+			action := "exclude"
+			if r.Include {
+				action = "include"
+			}
+			rules = append(rules, r.Name+"="+action)
+		}
+		return "[" + strings.Join(rules, ", ") + "]"
+	case *DataScrubber:
+		if x == nil {
+			return ""
+		}
+		return fmt.Sprintf("enabled=%t sensitive_patterns=%d custom_patterns=%d positional_args=%d url_credentials=%t structured_args=%t hash_values=%t",
+			x.Enabled, len(x.SensitivePatterns), len(x.CustomPatterns), len(x.PositionalArgs), x.ScrubURLCredentials, x.ScrubStructuredArgs, x.HashValues)
+	case *LoggerConfig:
+		if x == nil {
+			return ""
+		}
+		return fmt.Sprintf("%+v", *x)
+	case []string:
+		return "[" + strings.Join(x, ", ") + "]"
+	case map[string]time.Duration:
+		return formatMap(len(x), func(add func(string, string)) {
+			for k, d := range x {
+				add(k, d.String())
+			}
+		})
+	case map[string][]string:
+		return formatMap(len(x), func(add func(string, string)) {
+			for k, s := range x {
+				add(k, strings.Join(s, "|"))
+			}
+		})
+	}
+	return fmt.Sprint(v)
+}
+
+func formatMap(n int, each func(add func(string, string))) string {
+	entries := make([]string, 0, n)
+	each(func(k, v string) { entries = append(entries, k+"="+v) })
+	sort.Strings(entries)
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+// redact hides all but the last 5 characters of a secret.
+func redact(s string) string {
+	if len(s) <= 5 {
+		return strings.Repeat("*", len(s))
+	}
+	return strings.Repeat("*", len(s)-5) + s[len(s)-5:]
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestFile(t *testing.T, dir, name string, lines ...string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func findField(fields []Field, name string) Field {
+	for _, f := range fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return Field{}
+}
+
+func TestCheckConfig(t *testing.T) {
+	assert := assert.New(t)
+	dir, err := ioutil.TempDir("", "process-agent-configcheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	iniPath := writeTestFile(t, dir, "datadog.conf",
+		"[Main]",
+		"api_key = apikey_from_ini",
+		"# Keys of the dd-agent aren't reported",
+		"use_mount = no",
+		"",
+		"[process.config]",
+		"queue_size = 15",
+		"blacklist = ^getty,[a-",
+		"process_interval = ten",
+		"unknown_key = 1",
+		"allow_real_time = maybe",
+	)
+	yamlPath := writeTestFile(t, dir, "datadog.yaml",
+		"api_key: apikey_from_yaml",
+		"process_config:",
+		"  enabled: 'true'",
+		"  blacklist_patterns:",
+		"    - '^bash'",
+		"    - '(unclosed'",
+		"  intervals:",
+		"    process: 30",
+		"    proces_realtime: 1",
+		"  payload_sinks:",
+		"    '*': [http, kafka]",
+		"  process_dd_url: 'not a url'",
+	)
+	os.Setenv("DD_FILE_SINK_MAX_FILES", "five")
+	defer os.Unsetenv("DD_FILE_SINK_MAX_FILES")
+	os.Setenv("DD_PROMETHEUS_MAX_PROCESSES", "50")
+	defer os.Unsetenv("DD_PROMETHEUS_MAX_PROCESSES")
+
+	res, err := CheckConfig(iniPath, yamlPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	problems := make([]string, 0, len(res.Problems))
+	for _, p := range res.Problems {
+		problems = append(problems, p.String())
+	}
+	assert.Equal([]string{
+		iniPath + ":8: blacklist: invalid pattern: error parsing regexp: missing closing ]: `[a-`",
+		iniPath + ":9: process_interval: invalid number 'ten'",
+		iniPath + ":10: unknown_key: unknown key",
+		iniPath + ":11: allow_real_time: invalid boolean 'maybe'",
+		yamlPath + ":4: process_config.blacklist_patterns: invalid pattern: error parsing regexp: missing closing ): `(unclosed`",
+		yamlPath + ":9: process_config.intervals.proces_realtime: unknown key",
+		yamlPath + ":11: process_config.payload_sinks.*: unknown payload sink 'kafka', choose from: http, file",
+		yamlPath + ":12: process_config.process_dd_url: invalid URL 'not a url', expected scheme://host",
+		"environment: DD_FILE_SINK_MAX_FILES: invalid number 'five'",
+	}, problems)
+
+	assert.Equal(Field{Name: "QueueSize", Value: "15", Source: SourceIni}, findField(res.Fields, "QueueSize"))
+	assert.Equal(Field{Name: "PrometheusMaxProcesses", Value: "50", Source: SourceEnv}, findField(res.Fields, "PrometheusMaxProcesses"))
+	assert.Equal(Field{Name: "MaxProcFDs", Value: "200", Source: SourceDefault}, findField(res.Fields, "MaxProcFDs"))
+	// The invalid patterns are skipped
+	assert.Equal(Field{Name: "Blacklist", Value: "[^bash]", Source: SourceYaml}, findField(res.Fields, "Blacklist"))
+	assert.Equal(SourceYaml, findField(res.Fields, "CheckIntervals").Source)
+	assert.Contains(findField(res.Fields, "CheckIntervals").Value, "process=30s")
+	// Secrets are redacted
+	assert.Equal(Field{Name: "APIKey", Value: "***********_yaml", Source: SourceYaml}, findField(res.Fields, "APIKey"))
+	assert.Equal(Field{}, findField(res.Fields, "Transport"))
+}
+
+func TestCheckConfigMissingFiles(t *testing.T) {
+	res, err := CheckConfig("/does/not/exist.conf", "/does/not/exist.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, res.Problems, 0)
+	for _, f := range res.Fields {
+		assert.Equal(t, SourceDefault, f.Source, f.Name)
+	}
+}
+
+func TestCheckConfigYamlSyntax(t *testing.T) {
+	dir, err := ioutil.TempDir("", "process-agent-configcheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	yamlPath := writeTestFile(t, dir, "datadog.yaml",
+		"process_config:",
+		"  queue_size: many",
+	)
+	res, err := CheckConfig("", yamlPath)
+	assert.NoError(t, err)
+	if assert.Len(t, res.Problems, 1) {
+		assert.Equal(t, 2, res.Problems[0].Line)
+		assert.Contains(t, res.Problems[0].Message, "cannot unmarshal")
+	}
+}
+
+func TestYamlKeyLines(t *testing.T) {
+	lines := yamlKeyLines([]byte(strings.Join([]string{
+		"api_key: abc # comment",
+		"process_config:",
+		"  # comment: not a key",
+		"  intervals:",
+		"    process: 10",
+		"  payload_sinks:",
+		"    \"/api/v1/connections\": [file]",
+		"  custom_sensitive_words:",
+		"    - 'word: value'",
+		"dogstatsd_port: 8125",
+	}, "\n")))
+	assert.Equal(t, map[string]int{
+		"api_key":                                          1,
+		"process_config":                                   2,
+		"process_config.intervals":                         4,
+		"process_config.intervals.process":                 5,
+		"process_config.payload_sinks":                     6,
+		"process_config.payload_sinks./api/v1/connections": 7,
+		"process_config.custom_sensitive_words":            8,
+		"dogstatsd_port":                                   10,
+	}, lines)
+}
+
+func TestCheckConfigSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "process-agent-configcheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	backend := writeSecretBackend(t, dir, 0700,
+		`echo '{"key": {"value": "apikey_from_secret", "error": null}, "url": {"value": "https://intake.local", "error": null}}'`,
+	)
+	yamlPath := writeTestFile(t, dir, "datadog.yaml",
+		"api_key: ENC[key]",
+		"secret_backend_command: "+backend,
+		"process_config:",
+		"  process_dd_url: ENC[url]",
+		"  otlp_endpoint: ENC[unknown]",
+	)
+
+	res, err := CheckConfig("", yamlPath)
+	assert.NoError(t, err)
+	if assert.Len(t, res.Problems, 1) {
+		assert.Equal(t, yamlPath+": secret backend returned no secret for 'unknown'", res.Problems[0].String())
+	}
+
+	writeTestFile(t, dir, "datadog.yaml",
+		"api_key: ENC[key]",
+		"secret_backend_command: "+backend,
+		"process_config:",
+		"  process_dd_url: ENC[url]",
+	)
+	res, err = CheckConfig("", yamlPath)
+	assert.NoError(t, err)
+	assert.Len(t, res.Problems, 0)
+	assert.Equal(t, Field{Name: "APIKey", Value: "*************ecret", Source: SourceYaml}, findField(res.Fields, "APIKey"))
+	assert.Equal(t, Field{Name: "APIEndpoint", Value: "https://intake.local", Source: SourceYaml}, findField(res.Fields, "APIEndpoint"))
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"bytes"
+	"crypto/rand"
 	"fmt"
 	"net"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/util/docker"
@@ -29,12 +31,28 @@ var (
 
 	processChecks   = []string{"process", "rtprocess"}
 	containerChecks = []string{"container", "rtcontainer"}
+	// The system checks are opt-in, see AgentConfig.CollectSystemStats.
+	systemChecks = []string{"system", "rtsystem"}
 
 	// List of known Kubernetes images that we want to exclude by default.
 	defaultKubeBlacklist = []string{
 		"image:gcr.io/google_containers/pause.*",
 		"image:openshift/origin-pod",
 	}
+
+	// List of well-known container labels describing the containerized application
+	// that are shipped by default. Label values can hold sensitive data so only
+	// whitelisted labels are collected.
+	defaultContainerLabelWhitelist = []string{
+		"com.docker.compose.project",
+		"com.docker.compose.service",
+		"org.label-schema.*",
+		"org.opencontainers.image.*",
+	}
+
+	// defaultPrometheusProcessLabels are the labels attached to the process metrics
+	// exposed to Prometheus. The command line is left out as it's unbounded.
+	defaultPrometheusProcessLabels = []string{"pid", "name", "user", "container_id"}
 )
 
 type proxyFunc func(*http.Request) (*url.URL, error)
@@ -50,6 +68,7 @@ type AgentConfig struct {
 	LogLevel      string
 	QueueSize     int
 	Blacklist     []*regexp.Regexp
+	ProcessRules  []*ProcessRule
 	Scrubber      *DataScrubber
 	MaxProcFDs    int
 	ProcLimit     int
@@ -62,18 +81,62 @@ type AgentConfig struct {
 	StatsdHost    string
 	StatsdPort    int
 
+	// The target encoded size of the messages, their processes and containers
+	// being chunked by size when it's set, still with at most ProcLimit of them
+	// per message.
+	MaxMessageBytes int
+	// The maximum number of processes collected per run, 0 to collect them all.
+	// The top ProcessSampleTopN processes by CPU, memory, IO and file descriptors
+	// are always collected, with a rotating sample of the others.
+	ProcessSampleMax  int
+	ProcessSampleTopN int
+
+	// When set, the process payloads only carry the command and user of the
+	// processes which changed since the last one, every payload carrying them all
+	// again after ProcessDeltaResync or when the intake requests it.
+	ProcessDelta       bool
+	ProcessDeltaResync time.Duration
+
 	// Check config
 	EnabledChecks  []string
 	CheckIntervals map[string]time.Duration
+	// How often the SystemInfo is collected again to detect host changes.
+	SystemInfoInterval time.Duration
+	// Enables the system checks along with the other enabled checks.
+	CollectSystemStats bool
 
 	// Docker
-	ContainerBlacklist     []string
-	ContainerWhitelist     []string
-	CollectDockerNetwork   bool
-	ContainerCacheDuration time.Duration
+	ContainerBlacklist      []string
+	ContainerWhitelist      []string
+	ContainerLabelWhitelist []string
+	ContainerTopProcesses   int
+	CollectDockerNetwork    bool
+	ContainerCacheDuration  time.Duration
+
+	// Prometheus exposition endpoint, disabled when the address is empty
+	PrometheusListenAddr     string
+	PrometheusProcessLabels  []string
+	PrometheusProcessInclude []*regexp.Regexp
+	PrometheusProcessExclude []*regexp.Regexp
+	PrometheusMaxProcesses   int
+
+	// OTLP/HTTP metrics exporter, disabled when the endpoint is empty. When
+	// OTLPOnly is set payloads are no longer sent to the Datadog intake.
+	OTLPEndpoint string
+	OTLPOnly     bool
+
+	// Sinks receiving the payloads of each endpoint, see EndpointSinks.
+	PayloadSinks map[string][]string
+	// Local file sink writing payloads to rotating files in FileSinkDir.
+	FileSinkDir      string
+	FileSinkFormat   string
+	FileSinkMaxSize  int64
+	FileSinkMaxFiles int
 
 	// Internal store of a proxy used for generating the Transport
 	proxy proxyFunc
+	// The backend decrypting the ENC[handle] values, nil when not configured
+	secrets *secretBackend
 }
 
 // CheckIsEnabled returns a bool indicating if the given check name is enabled.
@@ -91,9 +154,44 @@ func (a AgentConfig) CheckInterval(checkName string) time.Duration {
 	return d
 }
 
+// EndpointSinks returns the sinks receiving the payloads submitted to the given
+// endpoint. Endpoints which aren't configured use the sinks of the "*" entry and
+// are only sent to the intake when there is none.
+func (a AgentConfig) EndpointSinks(endpoint string) []string {
+	if sinks, ok := a.PayloadSinks[endpoint]; ok {
+		return sinks
+	}
+	if sinks, ok := a.PayloadSinks["*"]; ok {
+		return sinks
+	}
+	return []string{SinkHTTP}
+}
+
+// UsesSink returns a bool indicating if any endpoint is sent to the given sink.
+func (a AgentConfig) UsesSink(sink string) bool {
+	for _, sinks := range a.PayloadSinks {
+		if util.StringInSlice(sinks, sink) {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload sinks
+const (
+	// SinkHTTP submits the payloads to the intake at APIEndpoint.
+	SinkHTTP = "http"
+	// SinkFile writes the payloads to local files.
+	SinkFile = "file"
+)
+
 const (
 	defaultEndpoint = "https://process.datadoghq.com"
-	maxProcLimit    = 100
+	// MaxProcLimit is the maximum number of processes or containers per message.
+	MaxProcLimit = 100
+	// defaultProcessSampleTopN is the number of top processes by each usage
+	// always kept when the processes are sampled.
+	defaultProcessSampleTopN = 10
 )
 
 // NewDefaultAgentConfig returns an AgentConfig with defaults initialized
@@ -104,6 +202,22 @@ func NewDefaultAgentConfig() *AgentConfig {
 		panic(err)
 	}
 
+	// Set default values for root/proc/sys paths if unset, before the containers
+	// are first listed.
+	// Don't set this is /host is not mounted to use context within container.
+	// Generally only applicable for container-only cases like Fargate.
+	if docker.IsContainerized() && util.PathExists("/host") {
+		if v := os.Getenv("HOST_PROC"); v == "" {
+			os.Setenv("HOST_PROC", "/host/proc")
+		}
+		if v := os.Getenv("HOST_SYS"); v == "" {
+			os.Setenv("HOST_SYS", "/host/sys")
+		}
+		if v := os.Getenv("HOST_ROOT"); v == "" {
+			os.Setenv("HOST_ROOT", "/host")
+		}
+	}
+
 	_, err = container.GetContainers()
 	canAccessContainers := err == nil
 
@@ -134,6 +248,12 @@ func NewDefaultAgentConfig() *AgentConfig {
 		StatsdHost: "127.0.0.1",
 		StatsdPort: 8125,
 
+		// Process sampling
+		ProcessSampleTopN: defaultProcessSampleTopN,
+
+		// Delta process payloads
+		ProcessDeltaResync: 10 * time.Minute,
+
 		// Path and environment for the dd-agent embedded python
 		DDAgentPy:    defaultDDAgentPy,
 		DDAgentPyEnv: []string{defaultDDAgentPyEnv},
@@ -146,28 +266,31 @@ func NewDefaultAgentConfig() *AgentConfig {
 			"container":   10 * time.Second,
 			"rtcontainer": 2 * time.Second,
 			"connections": 3 * 60 * time.Minute,
+			"system":      10 * time.Second,
+			"rtsystem":    2 * time.Second,
 		},
+		SystemInfoInterval: 5 * time.Minute,
 
 		// Docker
-		ContainerCacheDuration: 10 * time.Second,
-		CollectDockerNetwork:   true,
+		ContainerCacheDuration:  10 * time.Second,
+		CollectDockerNetwork:    true,
+		ContainerLabelWhitelist: defaultContainerLabelWhitelist,
+		ContainerTopProcesses:   5,
+
+		// Prometheus
+		PrometheusProcessLabels: defaultPrometheusProcessLabels,
+		PrometheusMaxProcesses:  100,
+
+		// Local file sink
+		FileSinkDir:      defaultFileSinkDir,
+		FileSinkFormat:   "binary",
+		FileSinkMaxSize:  10 * 1024 * 1024,
+		FileSinkMaxFiles: 5,
 
 		// DataScrubber to hide command line sensitive words
 		Scrubber: NewDefaultDataScrubber(),
 	}
 
-	// Set default values for proc/sys paths if unset.
-	// Don't set this is /host is not mounted to use context within container.
-	// Generally only applicable for container-only cases like Fargate.
-	if docker.IsContainerized() && util.PathExists("/host") {
-		if v := os.Getenv("HOST_PROC"); v == "" {
-			os.Setenv("HOST_PROC", "/host/proc")
-		}
-		if v := os.Getenv("HOST_SYS"); v == "" {
-			os.Setenv("HOST_SYS", "/host/sys")
-		}
-	}
-
 	if isRunningInKubernetes() {
 		ac.ContainerBlacklist = defaultKubeBlacklist
 	}
@@ -185,12 +308,100 @@ func NewAgentConfig(agentIni *File, agentYaml *YamlAgentConfig) (*AgentConfig, e
 	var err error
 	cfg := NewDefaultAgentConfig()
 
-	var ns string
-	var section *ini.Section
+	// Decrypt the secrets before anything is read from the config files.
+	if cfg.secrets, err = newSecretBackend(agentIni, agentYaml); err != nil {
+		return nil, err
+	}
+	if err := cfg.secrets.decryptFiles(agentIni, agentYaml); err != nil {
+		return nil, err
+	}
+
 	if agentIni != nil {
-		section, _ = agentIni.GetSection("Main")
+		cfg, err = mergeIniConfig(cfg, agentIni)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// For Agents >= 6 we will have a YAML config file to use.
+	if agentYaml != nil {
+		cfg, err = mergeYamlConfig(cfg, agentYaml)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Use environment to override any additional config.
+	cfg = mergeEnv(cfg)
+
+	if cfg.CollectSystemStats {
+		cfg.EnabledChecks = append(append([]string(nil), cfg.EnabledChecks...), systemChecks...)
+	}
+
+	// Python-style log level has WARNING vs WARN
+	if strings.ToLower(cfg.LogLevel) == "warning" {
+		cfg.LogLevel = "warn"
+	}
+
+	// (Re)configure the logging from our configuration
+	if err := NewLoggerLevel(cfg.LogLevel, cfg.LogFile); err != nil {
+		return nil, err
+	}
+
+	if cfg.HostName == "" {
+		if ecsutil.IsFargateInstance() {
+			// Fargate tasks should have no concept of host names, so we're using the task ARN.
+			if taskMeta, err := ecsutil.GetTaskMetadata(); err == nil {
+				cfg.HostName = fmt.Sprintf("fargate_task:%s", taskMeta.TaskARN)
+			} else {
+				log.Errorf("Failed to retrieve Fargate task metadata: %s", err)
+			}
+		} else if hostname, err := getHostname(cfg.DDAgentPy, cfg.DDAgentBin, cfg.DDAgentPyEnv); err == nil {
+			cfg.HostName = hostname
+		}
 	}
 
+	if cfg.proxy != nil {
+		cfg.Transport.Proxy = cfg.proxy
+	}
+
+	container.SetCacheDuration(cfg.ContainerCacheDuration)
+
+	if cfg.Scrubber.HashValues && len(cfg.Scrubber.HashKey) == 0 {
+		key, err := randomHashKey()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate the scrub hash key: %s", err)
+		}
+		cfg.Scrubber.HashKey = key
+	}
+
+	return cfg, nil
+}
+
+var (
+	hashKeyOnce sync.Once
+	hashKey     []byte
+	hashKeyErr  error
+)
+
+// randomHashKey returns the scrub hash key used when none is set, generated
+// once so that it is kept across the config reloads.
+func randomHashKey() ([]byte, error) {
+	hashKeyOnce.Do(func() {
+		log.Warn("No scrub_hash_key set, using a random key: the hashed values can only be correlated until the agent restarts")
+		hashKey = make([]byte, 32)
+		if _, hashKeyErr = rand.Read(hashKey); hashKeyErr != nil {
+			hashKey = nil
+		}
+	})
+	return hashKey, hashKeyErr
+}
+
+// mergeIniConfig applies the [Main] and [process.config] sections of the dd-agent config.
+func mergeIniConfig(cfg *AgentConfig, agentIni *File) (*AgentConfig, error) {
+	var ns string
+	section, _ := agentIni.GetSection("Main")
+
 	// Pull from the ini Agent config by default.
 	if section != nil {
 		a, err := agentIni.Get("Main", "api_key")
@@ -238,29 +449,39 @@ func NewAgentConfig(agentIni *File, agentYaml *YamlAgentConfig) (*AgentConfig, e
 		cfg.DDAgentPy = agentIni.GetDefault(ns, "dd_agent_py", cfg.DDAgentPy)
 		cfg.DDAgentPyEnv = agentIni.GetStrArrayDefault(ns, "dd_agent_py_env", ",", cfg.DDAgentPyEnv)
 
-		blacklistPats := agentIni.GetStrArrayDefault(ns, "blacklist", ",", []string{})
-		blacklist := make([]*regexp.Regexp, 0, len(blacklistPats))
-		for _, b := range blacklistPats {
-			r, err := regexp.Compile(b)
-			if err == nil {
-				blacklist = append(blacklist, r)
-			}
-		}
-		cfg.Blacklist = blacklist
+		cfg.Blacklist = compilePatterns(agentIni.GetStrArrayDefault(ns, "blacklist", ",", []string{}))
 
 		// DataScrubber
 		cfg.Scrubber.Enabled = agentIni.GetBool(ns, "scrub_args", true)
 		customSensitiveWords := agentIni.GetStrArrayDefault(ns, "custom_sensitive_words", ",", []string{})
 		cfg.Scrubber.AddCustomSensitiveWords(customSensitiveWords)
+		cfg.Scrubber.CustomPatterns = compilePatterns(agentIni.GetStrArrayDefault(ns, "scrub_patterns", ",", []string{}))
+		if v, _ := agentIni.Get(ns, "scrub_positional_args"); v != "" {
+			if cfg.Scrubber.PositionalArgs, err = parseScrubPositionalArgs(v); err != nil {
+				return nil, err
+			}
+		}
+		cfg.Scrubber.ScrubURLCredentials = agentIni.GetBool(ns, "scrub_url_credentials", cfg.Scrubber.ScrubURLCredentials)
+		cfg.Scrubber.ScrubStructuredArgs = agentIni.GetBool(ns, "scrub_structured_args", cfg.Scrubber.ScrubStructuredArgs)
+		cfg.Scrubber.HashValues = agentIni.GetBool(ns, "scrub_hash_values", cfg.Scrubber.HashValues)
+		if v, _ := agentIni.Get(ns, "scrub_hash_key"); v != "" {
+			cfg.Scrubber.HashKey = []byte(v)
+		}
 
+		cfg.MaxMessageBytes = agentIni.GetIntDefault(ns, "max_message_bytes", cfg.MaxMessageBytes)
 		procLimit := agentIni.GetIntDefault(ns, "proc_limit", cfg.ProcLimit)
-		if procLimit <= maxProcLimit {
+		if procLimit <= MaxProcLimit {
 			cfg.ProcLimit = procLimit
 		} else {
 			log.Warn("Overriding the configured process limit because it exceeds maximum")
-			cfg.ProcLimit = maxProcLimit
+			cfg.ProcLimit = MaxProcLimit
 		}
 
+		cfg.ProcessSampleMax = agentIni.GetIntDefault(ns, "process_sample_max", cfg.ProcessSampleMax)
+		cfg.ProcessSampleTopN = agentIni.GetIntDefault(ns, "process_sample_top_n", cfg.ProcessSampleTopN)
+		cfg.ProcessDelta = agentIni.GetBool(ns, "process_delta", cfg.ProcessDelta)
+		cfg.ProcessDeltaResync = agentIni.GetDurationDefault(ns, "process_delta_resync", time.Second, cfg.ProcessDeltaResync)
+
 		// Checks intervals can be overriden by configuration.
 		for checkName, defaultInterval := range cfg.CheckIntervals {
 			key := fmt.Sprintf("%s_interval", checkName)
@@ -271,76 +492,64 @@ func NewAgentConfig(agentIni *File, agentYaml *YamlAgentConfig) (*AgentConfig, e
 			}
 		}
 
+		// Prometheus config
+		cfg.PrometheusListenAddr = agentIni.GetDefault(ns, "prometheus_listen_addr", cfg.PrometheusListenAddr)
+		cfg.PrometheusProcessLabels = agentIni.GetStrArrayDefault(ns, "prometheus_process_labels", ",", cfg.PrometheusProcessLabels)
+		cfg.PrometheusProcessInclude = compilePatterns(agentIni.GetStrArrayDefault(ns, "prometheus_process_include", ",", []string{}))
+		cfg.PrometheusProcessExclude = compilePatterns(agentIni.GetStrArrayDefault(ns, "prometheus_process_exclude", ",", []string{}))
+		cfg.PrometheusMaxProcesses = agentIni.GetIntDefault(ns, "prometheus_max_processes", cfg.PrometheusMaxProcesses)
+
+		// OTLP config
+		cfg.OTLPEndpoint = agentIni.GetDefault(ns, "otlp_endpoint", cfg.OTLPEndpoint)
+		cfg.OTLPOnly = agentIni.GetBool(ns, "otlp_only", cfg.OTLPOnly)
+
+		// Payload sinks
+		if v, _ := agentIni.Get(ns, "payload_sinks"); v != "" {
+			if cfg.PayloadSinks, err = parsePayloadSinks(v); err != nil {
+				return nil, err
+			}
+		}
+		cfg.FileSinkDir = agentIni.GetDefault(ns, "file_sink_dir", cfg.FileSinkDir)
+		cfg.FileSinkFormat = agentIni.GetDefault(ns, "file_sink_format", cfg.FileSinkFormat)
+		cfg.FileSinkMaxSize = int64(agentIni.GetIntDefault(ns, "file_sink_max_size", int(cfg.FileSinkMaxSize)))
+		cfg.FileSinkMaxFiles = agentIni.GetIntDefault(ns, "file_sink_max_files", cfg.FileSinkMaxFiles)
+
+		cfg.SystemInfoInterval = agentIni.GetDurationDefault(ns, "system_info_interval", time.Second, cfg.SystemInfoInterval)
+		cfg.CollectSystemStats = agentIni.GetBool(ns, "collect_system_stats", cfg.CollectSystemStats)
+
 		// Docker config
 		cfg.CollectDockerNetwork = agentIni.GetBool(ns, "collect_docker_network", cfg.CollectDockerNetwork)
 		cfg.ContainerBlacklist = agentIni.GetStrArrayDefault(ns, "container_blacklist", ",", cfg.ContainerBlacklist)
 		cfg.ContainerWhitelist = agentIni.GetStrArrayDefault(ns, "container_whitelist", ",", cfg.ContainerWhitelist)
+		cfg.ContainerLabelWhitelist = agentIni.GetStrArrayDefault(ns, "container_label_whitelist", ",", cfg.ContainerLabelWhitelist)
+		cfg.ContainerTopProcesses = agentIni.GetIntDefault(ns, "container_top_processes", cfg.ContainerTopProcesses)
 		cfg.ContainerCacheDuration = agentIni.GetDurationDefault(ns, "container_cache_duration", time.Second, 30*time.Second)
 	}
 
-	// For Agents >= 6 we will have a YAML config file to use.
-	if agentYaml != nil {
-		cfg, err = mergeYamlConfig(cfg, agentYaml)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Use environment to override any additional config.
-	cfg = mergeEnv(cfg)
-
-	// Python-style log level has WARNING vs WARN
-	if strings.ToLower(cfg.LogLevel) == "warning" {
-		cfg.LogLevel = "warn"
-	}
-
-	// (Re)configure the logging from our configuration
-	if err := NewLoggerLevel(cfg.LogLevel, cfg.LogFile); err != nil {
-		return nil, err
-	}
-
-	if cfg.HostName == "" {
-		if ecsutil.IsFargateInstance() {
-			// Fargate tasks should have no concept of host names, so we're using the task ARN.
-			if taskMeta, err := ecsutil.GetTaskMetadata(); err == nil {
-				cfg.HostName = fmt.Sprintf("fargate_task:%s", taskMeta.TaskARN)
-			} else {
-				log.Errorf("Failed to retrieve Fargate task metadata: %s", err)
-			}
-		} else if hostname, err := getHostname(cfg.DDAgentPy, cfg.DDAgentBin, cfg.DDAgentPyEnv); err == nil {
-			cfg.HostName = hostname
-		}
-	}
-
-	if cfg.proxy != nil {
-		cfg.Transport.Proxy = cfg.proxy
-	}
-
 	return cfg, nil
 }
 
 // mergeEnv applies overrides from environment variables to the trace agent configuration
 func mergeEnv(c *AgentConfig) *AgentConfig {
-	var err error
-	if enabled, err := isAffirmative(os.Getenv("DD_PROCESS_AGENT_ENABLED")); enabled {
+	if enabled, err := isAffirmative(c.getEnv("DD_PROCESS_AGENT_ENABLED")); enabled {
 		c.Enabled = true
 		c.EnabledChecks = processChecks
 	} else if !enabled && err == nil {
 		c.Enabled = false
 	}
 
-	if v := os.Getenv("DD_HOSTNAME"); v != "" {
+	if v := c.getEnv("DD_HOSTNAME"); v != "" {
 		log.Info("overriding hostname from env DD_HOSTNAME value")
 		c.HostName = v
 	}
 
 	// Support API_KEY and DD_API_KEY but prefer DD_API_KEY.
 	var apiKey string
-	if v := os.Getenv("API_KEY"); v != "" {
+	if v := c.getEnv("API_KEY"); v != "" {
 		apiKey = v
 		log.Info("overriding API key from env API_KEY value")
 	}
-	if v := os.Getenv("DD_API_KEY"); v != "" {
+	if v := c.getEnv("DD_API_KEY"); v != "" {
 		apiKey = v
 		log.Info("overriding API key from env DD_API_KEY value")
 	}
@@ -353,40 +562,21 @@ func mergeEnv(c *AgentConfig) *AgentConfig {
 	}
 
 	// Support LOG_LEVEL and DD_LOG_LEVEL but prefer DD_LOG_LEVEL
-	if v := os.Getenv("LOG_LEVEL"); v != "" {
+	if v := c.getEnv("LOG_LEVEL"); v != "" {
 		c.LogLevel = v
 	}
-	if v := os.Getenv("DD_LOG_LEVEL"); v != "" {
+	if v := c.getEnv("DD_LOG_LEVEL"); v != "" {
 		c.LogLevel = v
 	}
-	if v := os.Getenv("DD_LOGS_STDOUT"); v == "yes" {
+	// Generated from the process_config fields, see processConfigEnv.
+	mergeProcessConfigEnv(c)
+
+	if v := c.getEnv("DD_LOGS_STDOUT"); v == "yes" {
 		// Empty log file implies logging to stdout and stdout
 		c.LogFile = ""
 	}
 
-	if c.proxy, err = proxyFromEnv(c.proxy); err != nil {
-		log.Errorf("error parsing proxy settings, not using a proxy: %s", err)
-		c.proxy = nil
-	}
-
-	if v := os.Getenv("DD_PROCESS_AGENT_URL"); v != "" {
-		u, err := url.Parse(v)
-		if err != nil {
-			log.Warnf("DD_PROCESS_AGENT_URL is invalid: %s", err)
-		} else {
-			log.Infof("overriding API endpoint from env")
-			c.APIEndpoint = u
-		}
-	}
-
-	if v := os.Getenv("DD_AGENT_PY"); v != "" {
-		c.DDAgentPy = v
-	}
-	if v := os.Getenv("DD_AGENT_PY_ENV"); v != "" {
-		c.DDAgentPyEnv = strings.Split(v, ",")
-	}
-
-	if v := os.Getenv("DD_DOGSTATSD_PORT"); v != "" {
+	if v := c.getEnv("DD_DOGSTATSD_PORT"); v != "" {
 		port, err := strconv.Atoi(v)
 		if err != nil {
 			log.Info("Failed to parse DD_DOGSTATSD_PORT: it should be a port number")
@@ -395,26 +585,97 @@ func mergeEnv(c *AgentConfig) *AgentConfig {
 		}
 	}
 
-	if v := os.Getenv("DD_BIND_HOST"); v != "" {
+	if v := c.getEnv("DD_BIND_HOST"); v != "" {
 		c.StatsdHost = v
 	}
 
-	// Docker config
-	if v := os.Getenv("DD_COLLECT_DOCKER_NETWORK"); v == "false" {
-		c.CollectDockerNetwork = false
+	return c
+}
+
+// getEnv returns the value of an environment variable, with its secret decrypted
+// when it is an ENC[handle] reference. Values which can't be decrypted are ignored.
+func (a *AgentConfig) getEnv(name string) string {
+	v, err := a.secrets.decryptEnv(name)
+	if err != nil {
+		log.Errorf("Ignoring %s: %s", name, err)
+		return ""
 	}
-	if v := os.Getenv("DD_CONTAINER_BLACKLIST"); v != "" {
-		c.ContainerBlacklist = strings.Split(v, ",")
+	return v
+}
+
+// parsePayloadSinks parses a comma-separated list of endpoint to sinks mappings,
+// with the sinks separated by '|', e.g. "*=http|file,/api/v1/connections=file".
+func parsePayloadSinks(v string) (map[string][]string, error) {
+	sinks := make(map[string][]string)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid payload sinks entry '%s', expected <endpoint>=<sink>", entry)
+		}
+		names, err := validSinks(strings.Split(parts[1], "|"))
+		if err != nil {
+			return nil, err
+		}
+		sinks[strings.TrimSpace(parts[0])] = names
 	}
-	if v := os.Getenv("DD_CONTAINER_WHITELIST"); v != "" {
-		c.ContainerWhitelist = strings.Split(v, ",")
+	return sinks, nil
+}
+
+// parseScrubPositionalArgs parses a comma-separated list of executable to
+// argument positions mappings, with the positions separated by '|', e.g.
+// "mysql=2,psql=3|4".
+func parseScrubPositionalArgs(v string) (map[string][]int, error) {
+	args := make(map[string][]int)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid scrub positional args entry '%s', expected <executable>=<position>", entry)
+		}
+		exe := strings.TrimSpace(parts[0])
+		for _, p := range strings.Split(parts[1], "|") {
+			pos, err := strconv.Atoi(strings.TrimSpace(p))
+			if err != nil || pos < 1 {
+				return nil, fmt.Errorf("invalid argument position '%s' for %s, expected a positive integer", p, exe)
+			}
+			args[exe] = append(args[exe], pos)
+		}
 	}
-	if v := os.Getenv("DD_CONTAINER_CACHE_DURATION"); v != "" {
-		durationS, _ := strconv.Atoi(v)
-		c.ContainerCacheDuration = time.Duration(durationS) * time.Second
+	return args, nil
+}
+
+// validSinks trims the given sink names and checks they are all known.
+func validSinks(names []string) ([]string, error) {
+	valid := make([]string, 0, len(names))
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n != SinkHTTP && n != SinkFile {
+			return nil, fmt.Errorf("unknown payload sink '%s', choose from: %s, %s", n, SinkHTTP, SinkFile)
+		}
+		valid = append(valid, n)
 	}
+	return valid, nil
+}
 
-	return c
+// compilePatterns compiles a list of regex patterns, skipping the invalid ones.
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		r, err := regexp.Compile(p)
+		if err != nil {
+			log.Warnf("Invalid regex pattern: %s", p)
+			continue
+		}
+		compiled = append(compiled, r)
+	}
+	return compiled
 }
 
 // IsBlacklisted returns a boolean indicating if the given command is blacklisted by our config.
@@ -428,6 +689,17 @@ func IsBlacklisted(cmdline []string, blacklist []*regexp.Regexp) bool {
 	return false
 }
 
+// parseBool parses the booleans accepted by File.GetBool.
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true, nil
+	case "0", "f", "false", "no", "n", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean '%s'", value)
+}
+
 func isAffirmative(value string) (bool, error) {
 	if value == "" {
 		return false, fmt.Errorf("value is empty")
@@ -478,18 +750,7 @@ func getHostname(ddAgentPy, ddAgentBin string, ddAgentEnv []string) (string, err
 // In the case of invalid settings an error is logged and nil is returned. If settings are missing,
 // meaning we don't want a proxy, then nil is returned with no error.
 func getProxySettings(m *ini.Section) (proxyFunc, error) {
-	var host string
-	scheme := "http"
-	if v := m.Key("proxy_host").MustString(""); v != "" {
-		// accept either http://myproxy.com or myproxy.com
-		if i := strings.Index(v, "://"); i != -1 {
-			// when available, parse the scheme from the url
-			scheme = v[0:i]
-			host = v[i+3:]
-		} else {
-			host = v
-		}
-	}
+	scheme, host := splitProxyHost(m.Key("proxy_host").MustString(""))
 
 	if host == "" {
 		return nil, nil
@@ -509,41 +770,14 @@ func getProxySettings(m *ini.Section) (proxyFunc, error) {
 	return constructProxy(host, scheme, port, user, password)
 }
 
-// proxyFromEnv parses out the proxy configuration from the ENV variables in a
-// similar way to getProxySettings and, if enough values are available, returns
-// a new proxy URL value. If the environment is not set for this then the
-// `defaultVal` is returned.
-func proxyFromEnv(defaultVal proxyFunc) (proxyFunc, error) {
-	var host string
-	scheme := "http"
-	if v := os.Getenv("PROXY_HOST"); v != "" {
-		// accept either http://myproxy.com or myproxy.com
-		if i := strings.Index(v, "://"); i != -1 {
-			// when available, parse the scheme from the url
-			scheme = v[0:i]
-			host = v[i+3:]
-		} else {
-			host = v
-		}
-	}
-
-	if host == "" {
-		return defaultVal, nil
+// splitProxyHost returns the scheme and the host of a proxy host setting, which
+// accepts either http://myproxy.com or myproxy.com. The scheme defaults to http.
+func splitProxyHost(v string) (string, string) {
+	if i := strings.Index(v, "://"); i != -1 {
+		// when available, parse the scheme from the url
+		return v[0:i], v[i+3:]
 	}
-
-	port := defaultProxyPort
-	if v := os.Getenv("PROXY_PORT"); v != "" {
-		port, _ = strconv.Atoi(v)
-	}
-	var user, password string
-	if v := os.Getenv("PROXY_USER"); v != "" {
-		user = v
-	}
-	if v := os.Getenv("PROXY_PASSWORD"); v != "" {
-		password = v
-	}
-
-	return constructProxy(host, scheme, port, user, password)
+	return "http", v
 }
 
 // constructProxy constructs a *url.Url for a proxy given the parts of a
@@ -4,6 +4,7 @@ package config
 
 const (
 	defaultLogFilePath = "/var/log/datadog/process-agent.log"
+	defaultFileSinkDir = "/var/log/datadog/process-payloads"
 
 	// Agent 5
 	defaultDDAgentPy    = "/opt/datadog-agent/embedded/bin/python"
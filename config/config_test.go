@@ -211,6 +211,15 @@ func TestDDAgentConfigYamlOnly(t *testing.T) {
 	assert.Equal(8*time.Second, agentConfig.CheckIntervals["container"])
 	assert.Equal(30*time.Second, agentConfig.CheckIntervals["process"])
 
+	// The system checks are opt-in
+	collect := true
+	ddy.Process.CollectSystemStats = &collect
+	agentConfig, err = NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	assert.Equal([]string{"process", "rtprocess", "system", "rtsystem"}, agentConfig.EnabledChecks)
+	assert.Equal([]string{"process", "rtprocess"}, processChecks)
+	ddy.Process.CollectSystemStats = nil
+
 	err = yaml.Unmarshal([]byte(strings.Join([]string{
 		"api_key: apikey_20",
 		"process_agent_enabled: true",
@@ -252,8 +261,305 @@ func TestDDAgentConfigYamlOnly(t *testing.T) {
 	assert.Equal(containerChecks, agentConfig.EnabledChecks)
 }
 
+func TestPayloadSinks(t *testing.T) {
+	assert := assert.New(t)
+
+	// Everything is sent to the intake by default
+	cfg := NewDefaultAgentConfig()
+	assert.Equal([]string{SinkHTTP}, cfg.EndpointSinks("/api/v1/collector"))
+	assert.False(cfg.UsesSink(SinkFile))
+
+	sinks, err := parsePayloadSinks("*=http|file, /api/v1/connections=file")
+	assert.NoError(err)
+	cfg.PayloadSinks = sinks
+	assert.Equal([]string{SinkHTTP, SinkFile}, cfg.EndpointSinks("/api/v1/collector"))
+	assert.Equal([]string{SinkFile}, cfg.EndpointSinks("/api/v1/connections"))
+	assert.True(cfg.UsesSink(SinkFile))
+
+	_, err = parsePayloadSinks("/api/v1/collector")
+	assert.Error(err)
+	_, err = parsePayloadSinks("/api/v1/collector=kafka")
+	assert.Error(err)
+
+	var ddy YamlAgentConfig
+	err = yaml.Unmarshal([]byte(strings.Join([]string{
+		"process_config:",
+		"  payload_sinks:",
+		"    /api/v1/container: [file]",
+		"  file_sink_dir: /tmp/payloads",
+		"  file_sink_format: json",
+		"  file_sink_max_files: 2",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	agentConfig, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	assert.Equal([]string{SinkFile}, agentConfig.EndpointSinks("/api/v1/container"))
+	assert.Equal([]string{SinkHTTP}, agentConfig.EndpointSinks("/api/v1/collector"))
+	assert.Equal("/tmp/payloads", agentConfig.FileSinkDir)
+	assert.Equal("json", agentConfig.FileSinkFormat)
+	assert.Equal(int64(10*1024*1024), agentConfig.FileSinkMaxSize)
+	assert.Equal(2, agentConfig.FileSinkMaxFiles)
+
+	ddy.Process.PayloadSinks["/api/v1/container"] = []string{"s3"}
+	_, err = NewAgentConfig(nil, &ddy)
+	assert.Error(err)
+}
+
+func TestScrubConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	args, err := parseScrubPositionalArgs("mysql=2, psql=3|4")
+	assert.NoError(err)
+	assert.Equal(map[string][]int{"mysql": {2}, "psql": {3, 4}}, args)
+	_, err = parseScrubPositionalArgs("mysql")
+	assert.Error(err)
+	_, err = parseScrubPositionalArgs("mysql=0")
+	assert.Error(err)
+
+	var ddy YamlAgentConfig
+	err = yaml.Unmarshal([]byte(strings.Join([]string{
+		"process_config:",
+		"  scrub_patterns: ['Bearer (\\S+)']",
+		"  scrub_positional_args:",
+		"    mysql: [2]",
+		"  scrub_url_credentials: true",
+		"  scrub_hash_values: true",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	agentConfig, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	scrubber := agentConfig.Scrubber
+	if assert.Len(scrubber.CustomPatterns, 1) {
+		assert.Equal(`Bearer (\S+)`, scrubber.CustomPatterns[0].String())
+	}
+	assert.Equal(map[string][]int{"mysql": {2}}, scrubber.PositionalArgs)
+	assert.True(scrubber.ScrubURLCredentials)
+	assert.False(scrubber.ScrubStructuredArgs)
+	assert.True(scrubber.HashValues)
+	// A random key is generated when none is set, and kept on reload
+	assert.Len(scrubber.HashKey, 32)
+	reloaded, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	assert.Equal(scrubber.HashKey, reloaded.Scrubber.HashKey)
+
+	ddy.Process.ScrubPositionalArgs["mysql"] = []int{0}
+	assert.NotEmpty(checkProcessConfig(&ddy.Process))
+}
+
+func TestYamlProcessConfig(t *testing.T) {
+	assert := assert.New(t)
+	var ddy YamlAgentConfig
+	err := yaml.Unmarshal([]byte(strings.Join([]string{
+		"hostname: yaml-host",
+		"process_config:",
+		"  allow_real_time: false",
+		"  intervals:",
+		"    process_realtime: 4",
+		"    connections: 600",
+		"  scrub_args: false",
+		"  dd_agent_py: /opt/python",
+		"  dd_agent_env: [A=1, B=2]",
+		"  proxy:",
+		"    host: https://proxy.local",
+		"    user: foo",
+		"  statsd_host: 10.0.0.1",
+		"  statsd_port: 8126",
+		"  container_blacklist: ['image:redis.*']",
+		"  container_whitelist: ['name:web']",
+		"  collect_docker_network: false",
+		"  container_cache_duration: 30",
+		"  otlp_only: true",
+		"  process_sample_max: 500",
+		"  max_message_bytes: 1048576",
+		"  process_delta: true",
+		"  process_delta_resync: 300",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	agentConfig, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	assert.Equal("yaml-host", agentConfig.HostName)
+	assert.False(agentConfig.AllowRealTime)
+	assert.Equal(4*time.Second, agentConfig.CheckIntervals["rtprocess"])
+	assert.Equal(10*time.Second, agentConfig.CheckIntervals["process"])
+	assert.Equal(600*time.Second, agentConfig.CheckIntervals["connections"])
+	assert.False(agentConfig.Scrubber.Enabled)
+	assert.Equal("/opt/python", agentConfig.DDAgentPy)
+	assert.Equal([]string{"A=1", "B=2"}, agentConfig.DDAgentPyEnv)
+	assert.Equal("10.0.0.1", agentConfig.StatsdHost)
+	assert.Equal(8126, agentConfig.StatsdPort)
+	assert.Equal([]string{"image:redis.*"}, agentConfig.ContainerBlacklist)
+	assert.Equal([]string{"name:web"}, agentConfig.ContainerWhitelist)
+	assert.False(agentConfig.CollectDockerNetwork)
+	assert.Equal(30*time.Second, agentConfig.ContainerCacheDuration)
+	assert.True(agentConfig.OTLPOnly)
+	assert.Equal(500, agentConfig.ProcessSampleMax)
+	assert.Equal(10, agentConfig.ProcessSampleTopN)
+	assert.Empty(checkProcessConfig(&ddy.Process))
+	// The top processes of the 4 usages must fit in the sample
+	ddy.Process.ProcessSampleMax = 30
+	assert.NotEmpty(checkProcessConfig(&ddy.Process))
+	ddy.Process.ProcessSampleTopN = 5
+	assert.Empty(checkProcessConfig(&ddy.Process))
+	ddy.Process.ProcessSampleMax = 500
+	ddy.Process.ProcessSampleTopN = 0
+	assert.Equal(1048576, agentConfig.MaxMessageBytes)
+	assert.True(agentConfig.ProcessDelta)
+	assert.Equal(300*time.Second, agentConfig.ProcessDeltaResync)
+	if assert.NotNil(agentConfig.proxy) {
+		u, err := agentConfig.proxy(&http.Request{})
+		assert.NoError(err)
+		assert.Equal("https://foo@proxy.local:3128", u.String())
+	}
+
+	// The fields which aren't set keep their ini value
+	dd, _ := ini.Load([]byte(strings.Join([]string{
+		"[Main]",
+		"api_key = apikey_12",
+		"[process.config]",
+		"blacklist = ^bash",
+		"scrub_args = false",
+	}, "\n")))
+	agentConfig, err = NewAgentConfig(&File{instance: dd, Path: "whatever"}, &YamlAgentConfig{})
+	assert.NoError(err)
+	assert.Len(agentConfig.Blacklist, 1)
+	assert.False(agentConfig.Scrubber.Enabled)
+	assert.True(agentConfig.AllowRealTime)
+}
+
+func TestProcessRules(t *testing.T) {
+	assert := assert.New(t)
+	var ddy YamlAgentConfig
+	err := yaml.Unmarshal([]byte(strings.Join([]string{
+		"process_config:",
+		"  process_rules:",
+		"    - name: no-zombies",
+		"      action: exclude",
+		"      states: [Z]",
+		"    - name: busy-java",
+		"      action: include",
+		"      command: '^java '",
+		"      min_cpu_pct: 1",
+		"    - action: include",
+		"      uid: 0",
+		"      min_rss_mb: 50",
+		"    - name: web",
+		"      action: include",
+		"      container_image: '^nginx'",
+		"      container_labels:",
+		"        team: web",
+		"        tier: ''",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	cfg, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	if !assert.Len(cfg.ProcessRules, 4) {
+		return
+	}
+	assert.Equal("process_rules[2]", cfg.ProcessRules[2].Name)
+
+	labels := map[string]string{"team": "web", "tier": "front"}
+	for _, tc := range []struct {
+		process   ProcessAttributes
+		collected bool
+		rule      string
+	}{
+		{ProcessAttributes{Cmdline: []string{"java", "-jar"}, State: "Z", CPUPct: 5}, false, "no-zombies"},
+		{ProcessAttributes{Cmdline: []string{"java", "-jar"}, State: "S", CPUPct: 5}, true, "busy-java"},
+		// Below the thresholds, no include rule matches
+		{ProcessAttributes{Cmdline: []string{"java", "-jar"}, State: "S", CPUPct: 0.5}, false, ""},
+		{ProcessAttributes{Cmdline: []string{"sshd"}, UID: 0, RSS: 60 * 1024 * 1024}, true, "process_rules[2]"},
+		{ProcessAttributes{Cmdline: []string{"sshd"}, UID: 1000, RSS: 60 * 1024 * 1024}, false, ""},
+		{ProcessAttributes{Cmdline: []string{"nginx"}, ContainerID: "abc", ContainerImage: "nginx:1.13",
+			ContainerLabels: func() map[string]string { return labels }}, true, "web"},
+		{ProcessAttributes{Cmdline: []string{"nginx"}, ContainerID: "abc", ContainerImage: "nginx:1.13",
+			ContainerLabels: func() map[string]string { return map[string]string{"team": "web"} }}, false, ""},
+	} {
+		collected, rule := MatchProcessRules(cfg.ProcessRules, &tc.process)
+		assert.Equal(tc.collected, collected, "%v", tc.process.Cmdline)
+		if tc.rule == "" {
+			assert.Nil(rule)
+		} else if assert.NotNil(rule) {
+			assert.Equal(tc.rule, rule.Name)
+		}
+	}
+
+	// Without include rules the processes matching no rule are collected
+	collected, rule := MatchProcessRules(cfg.ProcessRules[:1], &ProcessAttributes{Cmdline: []string{"bash"}})
+	assert.True(collected)
+	assert.Nil(rule)
+
+	// An invalid rule fails the configuration
+	ddy.Process.ProcessRules = []ProcessRuleConfig{{Action: "drop"}}
+	_, err = NewAgentConfig(nil, &ddy)
+	assert.EqualError(err, "process_rules[0]: invalid action 'drop', expected include or exclude")
+}
+
+func TestProcessConfigEnv(t *testing.T) {
+	assert := assert.New(t)
+	names := make(map[string]string)
+	for _, v := range processConfigEnv() {
+		_, dup := names[v.Name]
+		assert.False(dup, "duplicate variable %s", v.Name)
+		names[v.Name] = v.Key
+	}
+	assert.Equal("process_dd_url", names["DD_PROCESS_AGENT_URL"])
+	assert.Equal("dd_agent_env", names["DD_AGENT_PY_ENV"])
+	assert.Equal("queue_size", names["DD_QUEUE_SIZE"])
+	assert.Equal("intervals.connections", names["DD_INTERVALS_CONNECTIONS"])
+	assert.Equal("proxy.host", names["PROXY_HOST"])
+	assert.Equal("proxy.password", names["PROXY_PASSWORD"])
+	assert.NotContains(names, "DD_PROXY_HOST")
+	assert.NotContains(names, "DD_ENABLED")
+
+	env := map[string]string{
+		"DD_PROCESS_AGENT_URL":        "http://localhost:8080",
+		"DD_QUEUE_SIZE":               "7",
+		"DD_INTERVALS_CONNECTIONS":    "60",
+		"DD_ALLOW_REAL_TIME":          "no",
+		"DD_CONTAINER_BLACKLIST":      "image:a, image:b",
+		"DD_COLLECT_DOCKER_NETWORK":   "false",
+		"DD_CONTAINER_CACHE_DURATION": "5",
+		"DD_PAYLOAD_SINKS":            "*=http|file",
+		"DD_FILE_SINK_MAX_SIZE":       "1024",
+		// Invalid values are left out
+		"DD_MAX_PROC_FDS":     "many",
+		"DD_FILE_SINK_FORMAT": "xml",
+	}
+	for k, v := range env {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+
+	agentConfig, err := NewAgentConfig(nil, nil)
+	assert.NoError(err)
+	assert.Equal("localhost:8080", agentConfig.APIEndpoint.Host)
+	assert.Equal(7, agentConfig.QueueSize)
+	assert.Equal(60*time.Second, agentConfig.CheckIntervals["connections"])
+	assert.False(agentConfig.AllowRealTime)
+	assert.Equal([]string{"image:a", "image:b"}, agentConfig.ContainerBlacklist)
+	assert.False(agentConfig.CollectDockerNetwork)
+	assert.Equal(5*time.Second, agentConfig.ContainerCacheDuration)
+	assert.Equal([]string{SinkHTTP, SinkFile}, agentConfig.EndpointSinks("/api/v1/collector"))
+	assert.Equal(int64(1024), agentConfig.FileSinkMaxSize)
+	assert.Equal(200, agentConfig.MaxProcFDs)
+	assert.Equal("binary", agentConfig.FileSinkFormat)
+
+	_, problems := processConfigFromEnv(os.Getenv)
+	assert.Equal([]Problem{
+		{File: envSource, Key: "DD_MAX_PROC_FDS", Message: "invalid number 'many'"},
+		{File: envSource, Key: "DD_FILE_SINK_FORMAT", Message: "invalid format 'xml', expected binary or json"},
+	}, problems)
+}
+
 func TestProxyEnv(t *testing.T) {
 	assert := assert.New(t)
+	for _, k := range []string{"PROXY_HOST", "PROXY_PORT", "PROXY_USER", "PROXY_PASSWORD"} {
+		defer os.Unsetenv(k)
+	}
 	for i, tc := range []struct {
 		host     string
 		port     int
@@ -291,14 +597,42 @@ func TestProxyEnv(t *testing.T) {
 		}
 		os.Setenv("PROXY_USER", tc.user)
 		os.Setenv("PROXY_PASSWORD", tc.pass)
-		pf, err := proxyFromEnv(nil)
+		agentConfig, err := NewAgentConfig(nil, nil)
 		assert.NoError(err, "proxy case %d had error", i)
-		u, err := pf(&http.Request{})
+		u, err := agentConfig.Transport.Proxy(&http.Request{})
 		assert.NoError(err)
 		assert.Equal(tc.expected, u.String())
 	}
 }
 
+func TestProxyEnvOverridesYaml(t *testing.T) {
+	assert := assert.New(t)
+	var ddy YamlAgentConfig
+	err := yaml.Unmarshal([]byte(strings.Join([]string{
+		"api_key: apikey_20",
+		"process_config:",
+		"  proxy:",
+		"    host: yaml.example.com",
+		"    port: 1234",
+		"    user: foo",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	// The variables keep their legacy names and replace the yaml proxy as a whole
+	os.Setenv("PROXY_HOST", "https://env.example.com")
+	defer os.Unsetenv("PROXY_HOST")
+	os.Setenv("PROXY_PORT", "4567")
+	defer os.Unsetenv("PROXY_PORT")
+	os.Setenv("DD_PROXY_HOST", "ignored.example.com")
+	defer os.Unsetenv("DD_PROXY_HOST")
+
+	agentConfig, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	u, err := agentConfig.Transport.Proxy(&http.Request{})
+	assert.NoError(err)
+	assert.Equal("https://env.example.com:4567", u.String())
+}
+
 func getURL(f *ini.File) (*url.URL, error) {
 	conf := File{
 		f,
@@ -4,6 +4,7 @@ package config
 
 const (
 	defaultLogFilePath = "c:\\programdata\\datadog\\logs\\process-agent.log"
+	defaultFileSinkDir = "c:\\programdata\\datadog\\logs\\process-payloads"
 
 	// Agent 5
 	defaultDDAgentPy    = "c:\\Program Files\\Datadog\\Datadog Agent\\embedded\\python.exe"
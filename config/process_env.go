@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	log "github.com/cihub/seelog"
+)
+
+// processEnvVar is an environment variable overriding a field of ProcessConfig.
+type processEnvVar struct {
+	// The name of the variable, e.g. DD_QUEUE_SIZE.
+	Name string
+	// The dotted key of the field under process_config, e.g. intervals.process.
+	Key   string
+	index []int
+}
+
+// processConfigEnv returns the environment variables overriding the fields of
+// ProcessConfig. The name of a variable is DD_ followed by the uppercased key of
+// the field, the keys of nested sections being joined by '_', unless its env tag
+// gives another name. Fields tagged with env:"-" have no variable.
+func processConfigEnv() []processEnvVar {
+	return envVars(reflect.TypeOf(ProcessConfig{}), nil, nil)
+}
+
+func envVars(t reflect.Type, keys []string, index []int) []processEnvVar {
+	var vars []processEnvVar
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		name := f.Tag.Get("env")
+		if key == "" || key == "-" || name == "-" {
+			continue
+		}
+		fieldKeys := append(append([]string{}, keys...), key)
+		fieldIndex := append(append([]int{}, index...), i)
+		if f.Type.Kind() == reflect.Struct {
+			vars = append(vars, envVars(f.Type, fieldKeys, fieldIndex)...)
+			continue
+		}
+		if name == "" {
+			name = "DD_" + strings.ToUpper(strings.Join(fieldKeys, "_"))
+		}
+		vars = append(vars, processEnvVar{Name: name, Key: strings.Join(fieldKeys, "."), index: fieldIndex})
+	}
+	return vars
+}
+
+// set parses the raw value of the variable into its field of p. Lists are
+// separated by ',' and the payload sinks use the format of parsePayloadSinks.
+func (v processEnvVar) set(p *ProcessConfig, raw string) error {
+	field := reflect.ValueOf(p).Elem().FieldByIndex(v.index)
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(raw)
+	case int, int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number '%s'", raw)
+		}
+		field.SetInt(n)
+	case *bool:
+		b, err := parseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(&b))
+	case []string:
+		values := strings.Split(raw, ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		field.Set(reflect.ValueOf(values))
+	case map[string][]string:
+		sinks, err := parsePayloadSinks(raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(sinks))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// processConfigFromEnv returns the process_config fields set in the environment,
+// read with getenv. The invalid variables are returned as problems and left out.
+func processConfigFromEnv(getenv func(string) string) (*ProcessConfig, []Problem) {
+	var p ProcessConfig
+	var problems []Problem
+	for _, v := range processConfigEnv() {
+		raw := getenv(v.Name)
+		if raw == "" {
+			continue
+		}
+		// Validate each variable on its own to only leave out the invalid ones.
+		var single ProcessConfig
+		err := v.set(&single, raw)
+		if err == nil {
+			if invalid := checkProcessConfig(&single); len(invalid) > 0 {
+				err = fmt.Errorf("%s", invalid[0].Message)
+			}
+		}
+		if err != nil {
+			problems = append(problems, Problem{File: envSource, Key: v.Name, Message: err.Error()})
+			continue
+		}
+		v.set(&p, raw)
+	}
+	return &p, problems
+}
+
+// mergeProcessConfigEnv applies the process_config fields set in the environment.
+func mergeProcessConfigEnv(c *AgentConfig) {
+	p, problems := processConfigFromEnv(c.getEnv)
+	for _, problem := range problems {
+		log.Warnf("%s is invalid: %s", problem.Key, problem.Message)
+	}
+	if err := mergeProcessConfig(c, p); err != nil {
+		log.Warnf("Ignoring the process_config environment variables: %s", err)
+	}
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// ProcessRuleConfig is a rule of process_config.process_rules in datadog.yaml.
+// A rule matches the processes meeting all of its conditions, the patterns being
+// regular expressions.
+type ProcessRuleConfig struct {
+	// The name of the rule, shown by --check -explain-rules. Defaults to its index.
+	Name string `yaml:"name"`
+	// "include" or "exclude".
+	Action string `yaml:"action"`
+	// Patterns on the command line, its arguments joined by spaces, and on the
+	// path of the executable.
+	Command string `yaml:"command"`
+	Exe     string `yaml:"exe"`
+	// The name or the ID of the user running the process.
+	User string `yaml:"user"`
+	UID  *int32 `yaml:"uid"`
+	// Patterns on the ID and the image of the container of the process, and its
+	// labels. An empty label value matches any value.
+	ContainerID     string            `yaml:"container_id"`
+	ContainerImage  string            `yaml:"container_image"`
+	ContainerLabels map[string]string `yaml:"container_labels"`
+	// The states of the process, e.g. R, S, D or Z.
+	States []string `yaml:"states"`
+	// The minimum CPU usage, in percent, and the minimum RSS, in MB.
+	MinCPUPct float64 `yaml:"min_cpu_pct"`
+	MinRSSMB  uint64  `yaml:"min_rss_mb"`
+}
+
+// ProcessRule is a compiled ProcessRuleConfig.
+type ProcessRule struct {
+	Name            string
+	Include         bool
+	Command         *regexp.Regexp
+	Exe             *regexp.Regexp
+	User            string
+	UID             *int32
+	ContainerID     *regexp.Regexp
+	ContainerImage  *regexp.Regexp
+	ContainerLabels map[string]string
+	States          []string
+	MinCPUPct       float64
+	MinRSS          uint64
+}
+
+// ProcessAttributes are the attributes of a process the rules are matched on.
+type ProcessAttributes struct {
+	Cmdline        []string
+	Exe            string
+	User           string
+	UID            int32
+	ContainerID    string
+	ContainerImage string
+	// The labels of the container, only looked up by the rules matching on them.
+	ContainerLabels func() map[string]string
+	State           string
+	CPUPct          float64
+	RSS             uint64
+}
+
+// compileProcessRules compiles the rules of process_config.process_rules.
+func compileProcessRules(rules []ProcessRuleConfig) ([]*ProcessRule, error) {
+	compiled := make([]*ProcessRule, 0, len(rules))
+	for i, rc := range rules {
+		r, err := compileProcessRule(rc)
+		if err != nil {
+			return nil, fmt.Errorf("process_rules[%d]: %s", i, err)
+		}
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("process_rules[%d]", i)
+		}
+		compiled = append(compiled, r)
+	}
+	return compiled, nil
+}
+
+func compileProcessRule(rc ProcessRuleConfig) (*ProcessRule, error) {
+	r := &ProcessRule{
+		Name:            rc.Name,
+		User:            rc.User,
+		UID:             rc.UID,
+		ContainerLabels: rc.ContainerLabels,
+		States:          rc.States,
+		MinCPUPct:       rc.MinCPUPct,
+		MinRSS:          rc.MinRSSMB * 1024 * 1024,
+	}
+	switch strings.ToLower(rc.Action) {
+	case "include":
+		r.Include = true
+	case "exclude":
+	default:
+		return nil, fmt.Errorf("invalid action '%s', expected include or exclude", rc.Action)
+	}
+	for _, p := range []struct {
+		key     string
+		pattern string
+		re      **regexp.Regexp
+	}{
+		{"command", rc.Command, &r.Command},
+		{"exe", rc.Exe, &r.Exe},
+		{"container_id", rc.ContainerID, &r.ContainerID},
+		{"container_image", rc.ContainerImage, &r.ContainerImage},
+	} {
+		if p.pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(p.pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern: %s", p.key, err)
+		}
+		*p.re = re
+	}
+	if rc.MinCPUPct < 0 || rc.MinCPUPct > 100 {
+		return nil, fmt.Errorf("invalid min_cpu_pct %v, expected a percentage", rc.MinCPUPct)
+	}
+	return r, nil
+}
+
+// Matches returns whether the process meets all the conditions of the rule.
+func (r *ProcessRule) Matches(p *ProcessAttributes) bool {
+	if r.Command != nil && !r.Command.MatchString(strings.Join(p.Cmdline, " ")) {
+		return false
+	}
+	if r.Exe != nil && !r.Exe.MatchString(p.Exe) {
+		return false
+	}
+	if r.User != "" && r.User != p.User {
+		return false
+	}
+	if r.UID != nil && *r.UID != p.UID {
+		return false
+	}
+	if r.ContainerID != nil && !r.ContainerID.MatchString(p.ContainerID) {
+		return false
+	}
+	if r.ContainerImage != nil && !r.ContainerImage.MatchString(p.ContainerImage) {
+		return false
+	}
+	if len(r.States) > 0 && !util.StringInSlice(r.States, p.State) {
+		return false
+	}
+	if p.CPUPct < r.MinCPUPct || p.RSS < r.MinRSS {
+		return false
+	}
+	if len(r.ContainerLabels) > 0 {
+		if p.ContainerID == "" || p.ContainerLabels == nil {
+			return false
+		}
+		labels := p.ContainerLabels()
+		for k, v := range r.ContainerLabels {
+			if actual, ok := labels[k]; !ok || (v != "" && v != actual) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatchProcessRules returns whether the process is collected, and the first rule
+// matching it. The processes matched by no rule are collected unless there are
+// include rules, which then act as an allowlist.
+func MatchProcessRules(rules []*ProcessRule, p *ProcessAttributes) (bool, *ProcessRule) {
+	allowlist := false
+	for _, r := range rules {
+		if r.Matches(p) {
+			return r.Include, r
+		}
+		allowlist = allowlist || r.Include
+	}
+	return !allowlist, nil
+}
@@ -0,0 +1,382 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+const (
+	secretPrefix  = "ENC["
+	secretSuffix  = "]"
+	secretVersion = "1.0"
+
+	defaultSecretTimeout       = 5 * time.Second
+	defaultSecretOutputMaxSize = 1024 * 1024
+)
+
+// secretSettings are the settings of the secret backend, which are never
+// decrypted themselves.
+var secretSettings = []string{
+	"secret_backend_command",
+	"secret_backend_arguments",
+	"secret_backend_timeout",
+	"secret_backend_output_max_size",
+}
+
+// secretCache holds the decrypted secrets by backend command and handle for the
+// lifetime of the process, the executable being only invoked for new handles.
+var secretCache = struct {
+	sync.Mutex
+	values map[string]string
+}{values: make(map[string]string)}
+
+// secretBackend resolves the ENC[handle] references of the configuration by
+// invoking an external executable, following the protocol of the Datadog Agent:
+// it receives {"version": "1.0", "secrets": [<handle>...]} on its stdin and
+// writes {<handle>: {"value": <secret>, "error": <error or null>}...} to its stdout.
+type secretBackend struct {
+	command       string
+	args          []string
+	timeout       time.Duration
+	outputMaxSize int
+
+	mu sync.Mutex
+	// The secrets decrypted for this configuration, to redact them.
+	decrypted map[string]bool
+}
+
+type secretRequest struct {
+	Version string   `json:"version"`
+	Secrets []string `json:"secrets"`
+}
+
+type secretResponse struct {
+	Value    string  `json:"value"`
+	ErrorMsg *string `json:"error"`
+}
+
+// newSecretBackend returns the secret backend configured in the [Main] section of
+// the dd-agent config, datadog.yaml or the environment, by increasing precedence.
+// It returns nil when no backend command is configured.
+func newSecretBackend(agentIni *File, agentYaml *YamlAgentConfig) (*secretBackend, error) {
+	b := &secretBackend{
+		timeout:       defaultSecretTimeout,
+		outputMaxSize: defaultSecretOutputMaxSize,
+		decrypted:     make(map[string]bool),
+	}
+	if agentIni != nil {
+		b.command = agentIni.GetDefault("Main", "secret_backend_command", b.command)
+		b.args = agentIni.GetStrArrayDefault("Main", "secret_backend_arguments", ",", b.args)
+		b.timeout = agentIni.GetDurationDefault("Main", "secret_backend_timeout", time.Second, b.timeout)
+		b.outputMaxSize = agentIni.GetIntDefault("Main", "secret_backend_output_max_size", b.outputMaxSize)
+	}
+	if agentYaml != nil {
+		if agentYaml.SecretBackendCommand != "" {
+			b.command = agentYaml.SecretBackendCommand
+		}
+		if len(agentYaml.SecretBackendArguments) > 0 {
+			b.args = agentYaml.SecretBackendArguments
+		}
+		if agentYaml.SecretBackendTimeout > 0 {
+			b.timeout = time.Duration(agentYaml.SecretBackendTimeout) * time.Second
+		}
+		if agentYaml.SecretBackendOutputMaxSize > 0 {
+			b.outputMaxSize = agentYaml.SecretBackendOutputMaxSize
+		}
+	}
+	if v := os.Getenv("DD_SECRET_BACKEND_COMMAND"); v != "" {
+		b.command = v
+	}
+	if v := os.Getenv("DD_SECRET_BACKEND_ARGUMENTS"); v != "" {
+		b.args = strings.Split(v, ",")
+	}
+	if v := os.Getenv("DD_SECRET_BACKEND_TIMEOUT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DD_SECRET_BACKEND_TIMEOUT: %s", err)
+		}
+		b.timeout = time.Duration(n) * time.Second
+	}
+	if v := os.Getenv("DD_SECRET_BACKEND_OUTPUT_MAX_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DD_SECRET_BACKEND_OUTPUT_MAX_SIZE: %s", err)
+		}
+		b.outputMaxSize = n
+	}
+	if b.command == "" {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// secretHandle returns the handle of an ENC[handle] value.
+func secretHandle(v string) (string, bool) {
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, secretPrefix) || !strings.HasSuffix(v, secretSuffix) {
+		return "", false
+	}
+	return v[len(secretPrefix) : len(v)-len(secretSuffix)], true
+}
+
+// decrypt returns the secrets of the given handles, only invoking the backend
+// for the ones which aren't cached.
+func (b *secretBackend) decrypt(handles []string) (map[string]string, error) {
+	if b == nil {
+		return nil, fmt.Errorf("found ENC[] values but secret_backend_command is not set")
+	}
+	secrets := make(map[string]string, len(handles))
+	var missing []string
+	secretCache.Lock()
+	for _, h := range handles {
+		if v, ok := secretCache.values[b.command+"\x00"+h]; ok {
+			secrets[h] = v
+		} else if !util.StringInSlice(missing, h) {
+			missing = append(missing, h)
+		}
+	}
+	secretCache.Unlock()
+
+	if len(missing) > 0 {
+		fetched, err := b.fetch(missing)
+		if err != nil {
+			return nil, err
+		}
+		secretCache.Lock()
+		for h, v := range fetched {
+			secretCache.values[b.command+"\x00"+h] = v
+			secrets[h] = v
+		}
+		secretCache.Unlock()
+	}
+
+	b.mu.Lock()
+	for _, v := range secrets {
+		b.decrypted[v] = true
+	}
+	b.mu.Unlock()
+	return secrets, nil
+}
+
+// fetch invokes the backend executable for the given handles.
+func (b *secretBackend) fetch(handles []string) (map[string]string, error) {
+	if err := checkSecretBackendRights(b.command); err != nil {
+		return nil, fmt.Errorf("invalid secret_backend_command: %s", err)
+	}
+	input, err := json.Marshal(secretRequest{Version: secretVersion, Secrets: handles})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, b.command, b.args...)
+	cmd.Stdin = bytes.NewReader(input)
+	stdout := &limitedBuffer{max: b.outputMaxSize}
+	stderr := &limitedBuffer{max: b.outputMaxSize}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	start := time.Now()
+	err = cmd.Run()
+	log.Debugf("secret backend fetched %d secrets in %s", len(handles), time.Since(start))
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("secret backend timed out after %s", b.timeout)
+	}
+	if stdout.exceeded {
+		return nil, fmt.Errorf("secret backend output exceeds %d bytes", b.outputMaxSize)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("secret backend failed: %s: %s", err, strings.TrimSpace(stderr.buf.String()))
+	}
+
+	var res map[string]secretResponse
+	if err := json.Unmarshal(stdout.buf.Bytes(), &res); err != nil {
+		return nil, fmt.Errorf("invalid secret backend output: %s", err)
+	}
+	secrets := make(map[string]string, len(handles))
+	for _, h := range handles {
+		r, ok := res[h]
+		if !ok {
+			return nil, fmt.Errorf("secret backend returned no secret for '%s'", h)
+		}
+		if r.ErrorMsg != nil {
+			return nil, fmt.Errorf("secret backend failed to fetch '%s': %s", h, *r.ErrorMsg)
+		}
+		if r.Value == "" {
+			return nil, fmt.Errorf("secret backend returned an empty secret for '%s'", h)
+		}
+		secrets[h] = r.Value
+	}
+	return secrets, nil
+}
+
+// secretRef is a config value referencing a secret.
+type secretRef struct {
+	handle string
+	set    func(string)
+}
+
+// decryptFiles replaces the ENC[handle] values of the config files by their
+// secret, with a single invocation of the backend.
+func (b *secretBackend) decryptFiles(agentIni *File, agentYaml *YamlAgentConfig) error {
+	var refs []secretRef
+	if agentIni != nil {
+		for _, section := range agentIni.instance.Sections() {
+			for _, key := range section.Keys() {
+				if section.Name() == "Main" && util.StringInSlice(secretSettings, key.Name()) {
+					continue
+				}
+				if h, ok := secretHandle(key.String()); ok {
+					refs = append(refs, secretRef{h, key.SetValue})
+				}
+			}
+		}
+	}
+	if agentYaml != nil {
+		for _, v := range yamlStrings(reflect.ValueOf(agentYaml).Elem()) {
+			if h, ok := secretHandle(*v); ok {
+				v := v
+				refs = append(refs, secretRef{h, func(s string) { *v = s }})
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	handles := make([]string, 0, len(refs))
+	for _, r := range refs {
+		handles = append(handles, r.handle)
+	}
+	secrets, err := b.decrypt(handles)
+	if err != nil {
+		return err
+	}
+	for _, r := range refs {
+		r.set(secrets[r.handle])
+	}
+	return nil
+}
+
+// yamlStrings returns pointers to the strings of a YAML config value, skipping
+// the secret backend settings.
+func yamlStrings(v reflect.Value) []*string {
+	var values []*string
+	switch v.Kind() {
+	case reflect.String:
+		// Strings stored directly in maps can't be modified in place.
+		if v.CanAddr() {
+			values = append(values, v.Addr().Interface().(*string))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			name := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			if util.StringInSlice(secretSettings, name) {
+				continue
+			}
+			values = append(values, yamlStrings(v.Field(i))...)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, yamlStrings(v.Index(i))...)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			values = append(values, yamlStrings(v.MapIndex(k))...)
+		}
+	}
+	return values
+}
+
+// decryptEnv returns the value of an environment variable, with its secret
+// decrypted when it is an ENC[handle] reference.
+func (b *secretBackend) decryptEnv(name string) (string, error) {
+	v := os.Getenv(name)
+	h, ok := secretHandle(v)
+	if !ok {
+		return v, nil
+	}
+	secrets, err := b.decrypt([]string{h})
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt %s: %s", name, err)
+	}
+	return secrets[h], nil
+}
+
+// isSecret returns whether a value of the configuration was decrypted by the backend.
+func (b *secretBackend) isSecret(v string) bool {
+	if b == nil || v == "" {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.decrypted[v]
+}
+
+// limitedBuffer is a buffer failing the writes once it holds max bytes. It
+// doesn't embed bytes.Buffer so that io.Copy can't bypass Write with ReadFrom.
+type limitedBuffer struct {
+	buf      bytes.Buffer
+	max      int
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.buf.Len()+len(p) > b.max {
+		b.exceeded = true
+		return 0, fmt.Errorf("output exceeds %d bytes", b.max)
+	}
+	return b.buf.Write(p)
+}
+
+// Redacted returns a copy of the config to display, with the API key and the
+// values decrypted by the secret backend redacted, and without the scrubber
+// hash key.
+func (a *AgentConfig) Redacted() AgentConfig {
+	c := *a
+	v := reflect.ValueOf(&c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !f.CanSet() {
+			continue
+		}
+		switch x := f.Interface().(type) {
+		case string:
+			if a.secrets.isSecret(x) {
+				f.SetString(redact(x))
+			}
+		case []string:
+			redacted := make([]string, len(x))
+			for j, s := range x {
+				redacted[j] = s
+				if a.secrets.isSecret(s) {
+					redacted[j] = redact(s)
+				}
+			}
+			if !reflect.DeepEqual(redacted, x) {
+				f.Set(reflect.ValueOf(redacted))
+			}
+		}
+	}
+	c.APIKey = redact(a.APIKey)
+	if a.Scrubber != nil && a.Scrubber.HashKey != nil {
+		scrubber := *a.Scrubber
+		scrubber.HashKey = nil
+		c.Scrubber = &scrubber
+	}
+	return c
+}
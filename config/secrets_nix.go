@@ -0,0 +1,31 @@
+// +build !windows
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkSecretBackendRights checks the secret backend executable is a regular
+// file owned by the user running the agent, with no rights for group or others.
+func checkSecretBackendRights(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		return fmt.Errorf("%s is not executable by its owner", path)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("%s must have no rights for group or others, got %s", path, info.Mode().Perm())
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("%s must be owned by the user running the agent", path)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+// +build windows
+
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// checkSecretBackendRights checks the secret backend executable is a regular
+// file. Its ACLs are left to the installer on Windows.
+func checkSecretBackendRights(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package config
 import (
 	"fmt"
 	"net/url"
-	"regexp"
 	"strings"
 	"time"
 
@@ -19,51 +18,177 @@ import (
 // YamlAgentConfig is a sturcutre used for marshaling the datadog.yaml configuratio
 // available in Agent versions >= 6
 type YamlAgentConfig struct {
-	APIKey  string `yaml:"api_key"`
-	Process struct {
-		// A string indicate the enabled state of the Agent.
-		// If "false" (the default) we will only collect containers.
-		// If "true" we will collect containers and processes.
-		// If "disabled" the agent will be disabled altogether and won't start.
-		Enabled string `yaml:"enabled"`
-		// The full path to the file where process-agent logs will be written.
-		LogFile string `yaml:"log_file"`
-		// The interval, in seconds, at which we will run each check. If you want consistent
-		// behavior between real-time you may set the Container/ProcessRT intervals to 10.
-		// Defaults to 10s for normal checks and 2s for others.
-		Intervals struct {
-			Container         int `yaml:"container"`
-			ContainerRealTime int `yaml:"container_realtime"`
-			Process           int `yaml:"process"`
-			ProcessRealTime   int `yaml:"process_realtime"`
-		} `yaml:"intervals"`
-		// A list of regex patterns that will exclude a process if matched.
-		BlacklistPatterns []string `yaml:"blacklist_patterns"`
-		// Enable/Disable the DataScrubber to obfuscate process args
-		ScrubArgs bool `yaml:"scrub_args"`
-		// A custom word list to enhance the default one used by the DataScrubber
-		CustomSensitiveWords []string `yaml:"custom_sensitive_words"`
-		// How many check results to buffer in memory when POST fails. The default is usually fine.
-		QueueSize int `yaml:"queue_size"`
-		// The maximum number of file descriptors to open when collecting net connections.
-		// Only change if you are running out of file descriptors from the Agent.
-		MaxProcFDs int `yaml:"max_proc_fds"`
-		// The maximum number of processes or containers per message.
-		// Only change if the defaults are causing issues.
-		MaxPerMessage int `yaml:"max_per_message"`
-		// Overrides the path to the Agent bin used for getting the hostname. The default is usually fine.
-		DDAgentBin string `yaml:"dd_agent_bin"`
-		// Overrides of the environment we pass to fetch the hostname. The default is usually fine.
-		DDAgentEnv []string `yaml:"dd_agent_env"`
-		// Overrides the submission endpoint URL from the default
-		ProcessDDURL string `yaml:"process_dd_url"`
-	} `yaml:"process_config"`
+	APIKey string `yaml:"api_key"`
+	// Overrides the hostname detected by the Agent.
+	Hostname string `yaml:"hostname"`
+	// The executable fetching the secrets of the ENC[handle] values, see secretBackend,
+	// with its arguments, its timeout in seconds and the maximum size of its output.
+	SecretBackendCommand       string        `yaml:"secret_backend_command"`
+	SecretBackendArguments     []string      `yaml:"secret_backend_arguments"`
+	SecretBackendTimeout       int           `yaml:"secret_backend_timeout"`
+	SecretBackendOutputMaxSize int           `yaml:"secret_backend_output_max_size"`
+	Process                    ProcessConfig `yaml:"process_config"`
+}
+
+// ProcessConfig is the process_config section of datadog.yaml. Each field can also
+// be set with an environment variable, see processConfigEnv.
+type ProcessConfig struct {
+	// A string indicate the enabled state of the Agent.
+	// If "false" (the default) we will only collect containers.
+	// If "true" we will collect containers and processes.
+	// If "disabled" the agent will be disabled altogether and won't start.
+	// It is set from the environment with DD_PROCESS_AGENT_ENABLED, see mergeEnv.
+	Enabled string `yaml:"enabled" env:"-"`
+	// The full path to the file where process-agent logs will be written.
+	LogFile string `yaml:"log_file"`
+	// The interval, in seconds, at which we will run each check. If you want consistent
+	// behavior between real-time you may set the Container/ProcessRT intervals to 10.
+	// Defaults to 10s for normal checks, 2s for real-time ones and 3h for connections.
+	Intervals struct {
+		Container         int `yaml:"container"`
+		ContainerRealTime int `yaml:"container_realtime"`
+		Process           int `yaml:"process"`
+		ProcessRealTime   int `yaml:"process_realtime"`
+		System            int `yaml:"system"`
+		SystemRealTime    int `yaml:"system_realtime"`
+		Connections       int `yaml:"connections"`
+	} `yaml:"intervals"`
+	// The interval, in seconds, at which the host information (CPUs, memory, OS) is
+	// collected again to detect changes. Defaults to 5 minutes.
+	SystemInfoInterval int `yaml:"system_info_interval"`
+	// Collect the host load, CPU, memory and process count stats with the system and
+	// rtsystem checks. Defaults to false.
+	CollectSystemStats *bool `yaml:"collect_system_stats"`
+	// Allow the real-time checks to run when requested by the intake. Defaults to true.
+	AllowRealTime *bool `yaml:"allow_real_time"`
+	// A list of regex patterns that will exclude a process if matched.
+	BlacklistPatterns []string `yaml:"blacklist_patterns"`
+	// Rules including or excluding processes, evaluated in order after the blacklist,
+	// the first matching rule applying. When there are include rules, the processes
+	// matching no rule are excluded. See ProcessRuleConfig.
+	ProcessRules []ProcessRuleConfig `yaml:"process_rules" env:"-"`
+	// Enable/Disable the DataScrubber to obfuscate process args. Defaults to true.
+	ScrubArgs *bool `yaml:"scrub_args"`
+	// A custom word list to enhance the default one used by the DataScrubber
+	CustomSensitiveWords []string `yaml:"custom_sensitive_words"`
+	// Regex patterns whose capture groups, or whole match when they have none, are
+	// scrubbed from the command lines.
+	ScrubPatterns []string `yaml:"scrub_patterns"`
+	// The positions of the arguments always scrubbed by executable name, e.g.
+	// {mysql: [2]}. The first argument after the executable is at position 1.
+	ScrubPositionalArgs map[string][]int `yaml:"scrub_positional_args" env:"-"`
+	// Scrub the password of the URLs in the arguments. Defaults to false.
+	ScrubURLCredentials *bool `yaml:"scrub_url_credentials"`
+	// Scrub the values of the sensitive keys of the JSON and YAML arguments, parsing
+	// every argument which looks like one. Defaults to false.
+	ScrubStructuredArgs *bool `yaml:"scrub_structured_args"`
+	// Replace the scrubbed values with an HMAC-SHA256 prefix instead of a mask, so that
+	// they can be correlated. Defaults to false.
+	ScrubHashValues *bool `yaml:"scrub_hash_values"`
+	// The key of the HMAC of the scrubbed values, the same on the hosts whose values
+	// are correlated. A random key is used when it's not set, only valid until the
+	// agent restarts.
+	ScrubHashKey string `yaml:"scrub_hash_key"`
+	// How many check results to buffer in memory when POST fails. The default is usually fine.
+	QueueSize int `yaml:"queue_size"`
+	// The maximum number of file descriptors to open when collecting net connections.
+	// Only change if you are running out of file descriptors from the Agent.
+	MaxProcFDs int `yaml:"max_proc_fds"`
+	// The maximum number of processes or containers per message.
+	// Only change if the defaults are causing issues.
+	MaxPerMessage int `yaml:"max_per_message"`
+	// The target size in bytes of the encoded messages. When set, the processes and
+	// containers are chunked by size instead of by max_per_message, so messages hold
+	// as many small processes as fit and a few large ones.
+	MaxMessageBytes int `yaml:"max_message_bytes"`
+	// The maximum number of processes collected per run, 0 (the default) to collect
+	// them all. The top process_sample_top_n processes by CPU, memory, IO and file
+	// descriptors are always collected, with a rotating sample of the others, and the
+	// processes left out are summarized.
+	ProcessSampleMax int `yaml:"process_sample_max"`
+	// The number of top processes always collected for each resource. Defaults to 10.
+	ProcessSampleTopN int `yaml:"process_sample_top_n"`
+	// Only send the command and user of the processes when they changed since the
+	// previous process payload, a process being identified by its pid and creation
+	// time. Defaults to false.
+	ProcessDelta *bool `yaml:"process_delta"`
+	// The interval, in seconds, at which a process payload carries the command and
+	// user of every process again when process_delta is set. Defaults to 10 minutes.
+	ProcessDeltaResync int `yaml:"process_delta_resync"`
+	// Overrides the path to the Agent bin used for getting the hostname. The default is usually fine.
+	DDAgentBin string `yaml:"dd_agent_bin" env:"DD_AGENT_BIN"`
+	// Overrides the path to the dd-agent embedded python used for getting the hostname
+	// when there is no Agent bin.
+	DDAgentPy string `yaml:"dd_agent_py" env:"DD_AGENT_PY"`
+	// Overrides of the environment we pass to fetch the hostname. The default is usually fine.
+	DDAgentEnv []string `yaml:"dd_agent_env" env:"DD_AGENT_PY_ENV"`
+	// Overrides the submission endpoint URL from the default
+	ProcessDDURL string `yaml:"process_dd_url" env:"DD_PROCESS_AGENT_URL"`
+	// The proxy used to reach the submission endpoint. The host may include the scheme,
+	// e.g. "https://proxy.local". The port defaults to 3128. The environment variables
+	// keep the names read by the previous versions, e.g. PROXY_HOST.
+	Proxy struct {
+		Host     string `yaml:"host" env:"PROXY_HOST"`
+		Port     int    `yaml:"port" env:"PROXY_PORT"`
+		User     string `yaml:"user" env:"PROXY_USER"`
+		Password string `yaml:"password" env:"PROXY_PASSWORD"`
+	} `yaml:"proxy"`
+	// The address and port of the statsd server receiving the internal metrics. They
+	// default to the Agent bind_host and dogstatsd_port.
+	StatsdHost string `yaml:"statsd_host"`
+	StatsdPort int    `yaml:"statsd_port"`
+	// Patterns on the container name or image, e.g. "image:redis.*", of the containers
+	// which aren't collected.
+	ContainerBlacklist []string `yaml:"container_blacklist"`
+	// Patterns of the containers which are always collected, even if blacklisted.
+	ContainerWhitelist []string `yaml:"container_whitelist"`
+	// A list of container label keys that will be collected with the container metadata.
+	// A key ending with '*' matches every label starting with that prefix.
+	ContainerLabelWhitelist []string `yaml:"container_label_whitelist"`
+	// The number of top processes by CPU and by memory reported for each container.
+	// Set to -1 to disable.
+	ContainerTopProcesses int `yaml:"container_top_processes"`
+	// Collect the network stats of the containers. Defaults to true.
+	CollectDockerNetwork *bool `yaml:"collect_docker_network"`
+	// How long, in seconds, the container list is cached. Defaults to 10s.
+	ContainerCacheDuration int `yaml:"container_cache_duration"`
+	// The address of the Prometheus exposition endpoint, e.g. "localhost:9101". Disabled when empty.
+	PrometheusListenAddr string `yaml:"prometheus_listen_addr"`
+	// The labels attached to the process metrics, among pid, name, user, container_id and cmdline.
+	// Processes sharing the same label values are summed.
+	PrometheusProcessLabels []string `yaml:"prometheus_process_labels"`
+	// Regex patterns on the command line selecting the processes exposed to Prometheus.
+	PrometheusProcessInclude []string `yaml:"prometheus_process_include"`
+	// Regex patterns on the command line excluding processes from Prometheus.
+	PrometheusProcessExclude []string `yaml:"prometheus_process_exclude"`
+	// The maximum number of processes exposed to Prometheus, keeping the top ones by CPU.
+	PrometheusMaxProcesses int `yaml:"prometheus_max_processes"`
+	// The base URL of an OTLP/HTTP collector to push metrics to, e.g. "http://localhost:4318".
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+	// Only push metrics to the OTLP collector and not to the Datadog intake. Real-time
+	// collection is then never enabled as it is driven by the intake responses.
+	OTLPOnly *bool `yaml:"otlp_only"`
+	// The sinks receiving the payloads of each endpoint, among "http" (the Datadog intake)
+	// and "file". The "*" entry applies to all the endpoints that aren't listed, e.g.:
+	//   payload_sinks:
+	//     "*": [http, file]
+	//     /api/v1/connections: [file]
+	// From the environment the entries are separated by ',' and the sinks by '|'.
+	PayloadSinks map[string][]string `yaml:"payload_sinks"`
+	// The directory where the file sink writes the payloads.
+	FileSinkDir string `yaml:"file_sink_dir"`
+	// The format of the file sink, "binary" for length-prefixed encoded messages or
+	// "json" for newline-delimited JSON. Defaults to "binary".
+	FileSinkFormat string `yaml:"file_sink_format"`
+	// The size in bytes after which the payload file is rotated. Defaults to 10MB.
+	FileSinkMaxSize int64 `yaml:"file_sink_max_size"`
+	// The number of payload files kept, including the one being written. Defaults to 5.
+	FileSinkMaxFiles int `yaml:"file_sink_max_files"`
 }
 
 // NewYamlIfExists returns a new YamlAgentConfig if the given configPath is exists.
 func NewYamlIfExists(configPath string) (*YamlAgentConfig, error) {
 	var yamlConf YamlAgentConfig
-	yamlConf.Process.ScrubArgs = true
 	if util.PathExists(configPath) {
 		lines, err := util.ReadLines(configPath)
 		if err != nil {
@@ -79,6 +204,9 @@ func NewYamlIfExists(configPath string) (*YamlAgentConfig, error) {
 
 func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig, error) {
 	agentConf.APIKey = yc.APIKey
+	if yc.Hostname != "" {
+		agentConf.HostName = yc.Hostname
+	}
 
 	if enabled, err := isAffirmative(yc.Process.Enabled); enabled {
 		agentConf.Enabled = true
@@ -89,70 +217,223 @@ func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig,
 		agentConf.Enabled = true
 		agentConf.EnabledChecks = containerChecks
 	}
-	if yc.Process.ProcessDDURL != "" {
-		u, err := url.Parse(yc.Process.ProcessDDURL)
+
+	// Pull additional parameters from the global config file.
+	agentConf.LogLevel = ddconfig.Datadog.GetString("log_level")
+	if v := ddconfig.Datadog.GetString("bind_host"); v != "" {
+		agentConf.StatsdHost = v
+	}
+	agentConf.StatsdPort = ddconfig.Datadog.GetInt("dogstatsd_port")
+	agentConf.Transport = ddutil.CreateHTTPTransport()
+	agentConf.DDAgentBin = defaultDDAgentBin
+
+	if err := mergeProcessConfig(agentConf, &yc.Process); err != nil {
+		return nil, err
+	}
+	return agentConf, nil
+}
+
+// mergeProcessConfig applies the fields of a process_config section which are set,
+// either from datadog.yaml or from the environment.
+func mergeProcessConfig(agentConf *AgentConfig, p *ProcessConfig) error {
+	if p.ProcessDDURL != "" {
+		u, err := url.Parse(p.ProcessDDURL)
 		if err != nil {
-			return nil, fmt.Errorf("invalid process_dd_url: %s", err)
+			return fmt.Errorf("invalid process_dd_url: %s", err)
 		}
 		agentConf.APIEndpoint = u
 	}
-	if yc.Process.LogFile != "" {
-		agentConf.LogFile = yc.Process.LogFile
+	if p.Proxy.Host != "" {
+		scheme, host := splitProxyHost(p.Proxy.Host)
+		port := defaultProxyPort
+		if p.Proxy.Port != 0 {
+			port = p.Proxy.Port
+		}
+		proxy, err := constructProxy(host, scheme, port, p.Proxy.User, p.Proxy.Password)
+		if err != nil {
+			return fmt.Errorf("invalid proxy: %s", err)
+		}
+		agentConf.proxy = proxy
+	}
+	if p.LogFile != "" {
+		agentConf.LogFile = p.LogFile
 	}
-	if yc.Process.Intervals.Container != 0 {
-		log.Infof("Overriding container check interval to %ds", yc.Process.Intervals.Container)
-		agentConf.CheckIntervals["container"] = time.Duration(yc.Process.Intervals.Container) * time.Second
+
+	intervals := []struct {
+		check   string
+		seconds int
+	}{
+		{"container", p.Intervals.Container},
+		{"rtcontainer", p.Intervals.ContainerRealTime},
+		{"process", p.Intervals.Process},
+		{"rtprocess", p.Intervals.ProcessRealTime},
+		{"system", p.Intervals.System},
+		{"rtsystem", p.Intervals.SystemRealTime},
+		{"connections", p.Intervals.Connections},
+	}
+	for _, i := range intervals {
+		if i.seconds != 0 {
+			log.Infof("Overriding %s check interval to %ds", i.check, i.seconds)
+			agentConf.CheckIntervals[i.check] = time.Duration(i.seconds) * time.Second
+		}
 	}
-	if yc.Process.Intervals.ContainerRealTime != 0 {
-		log.Infof("Overriding real-time container check interval to %ds", yc.Process.Intervals.ContainerRealTime)
-		agentConf.CheckIntervals["rtcontainer"] = time.Duration(yc.Process.Intervals.ContainerRealTime) * time.Second
+	if p.SystemInfoInterval != 0 {
+		log.Infof("Overriding system info interval to %ds", p.SystemInfoInterval)
+		agentConf.SystemInfoInterval = time.Duration(p.SystemInfoInterval) * time.Second
 	}
-	if yc.Process.Intervals.Process != 0 {
-		log.Infof("Overriding process check interval to %ds", yc.Process.Intervals.Process)
-		agentConf.CheckIntervals["process"] = time.Duration(yc.Process.Intervals.Process) * time.Second
+	if p.AllowRealTime != nil {
+		agentConf.AllowRealTime = *p.AllowRealTime
 	}
-	if yc.Process.Intervals.ProcessRealTime != 0 {
-		log.Infof("Overriding real-time process check interval to %ds", yc.Process.Intervals.ProcessRealTime)
-		agentConf.CheckIntervals["rtprocess"] = time.Duration(yc.Process.Intervals.Process) * time.Second
+	if p.CollectSystemStats != nil {
+		agentConf.CollectSystemStats = *p.CollectSystemStats
 	}
-	blacklist := make([]*regexp.Regexp, 0, len(yc.Process.BlacklistPatterns))
-	for _, b := range yc.Process.BlacklistPatterns {
-		r, err := regexp.Compile(b)
+	if len(p.BlacklistPatterns) > 0 {
+		agentConf.Blacklist = compilePatterns(p.BlacklistPatterns)
+	}
+	if len(p.ProcessRules) > 0 {
+		rules, err := compileProcessRules(p.ProcessRules)
 		if err != nil {
-			log.Warnf("Invalid blacklist pattern: %s", b)
+			return err
+		}
+		agentConf.ProcessRules = rules
+	}
+
+	if p.PrometheusListenAddr != "" {
+		agentConf.PrometheusListenAddr = p.PrometheusListenAddr
+	}
+	if len(p.PrometheusProcessLabels) > 0 {
+		agentConf.PrometheusProcessLabels = p.PrometheusProcessLabels
+	}
+	if len(p.PrometheusProcessInclude) > 0 {
+		agentConf.PrometheusProcessInclude = compilePatterns(p.PrometheusProcessInclude)
+	}
+	if len(p.PrometheusProcessExclude) > 0 {
+		agentConf.PrometheusProcessExclude = compilePatterns(p.PrometheusProcessExclude)
+	}
+	if p.PrometheusMaxProcesses != 0 {
+		agentConf.PrometheusMaxProcesses = p.PrometheusMaxProcesses
+	}
+
+	if p.OTLPEndpoint != "" {
+		agentConf.OTLPEndpoint = p.OTLPEndpoint
+	}
+	if p.OTLPOnly != nil {
+		agentConf.OTLPOnly = *p.OTLPOnly
+	}
+
+	if len(p.PayloadSinks) > 0 {
+		sinks := make(map[string][]string, len(p.PayloadSinks))
+		for endpoint, names := range p.PayloadSinks {
+			valid, err := validSinks(names)
+			if err != nil {
+				return fmt.Errorf("invalid payload_sinks: %s", err)
+			}
+			sinks[endpoint] = valid
 		}
-		blacklist = append(blacklist, r)
+		agentConf.PayloadSinks = sinks
+	}
+	if p.FileSinkDir != "" {
+		agentConf.FileSinkDir = p.FileSinkDir
+	}
+	if p.FileSinkFormat != "" {
+		agentConf.FileSinkFormat = p.FileSinkFormat
+	}
+	if p.FileSinkMaxSize > 0 {
+		agentConf.FileSinkMaxSize = p.FileSinkMaxSize
+	}
+	if p.FileSinkMaxFiles > 0 {
+		agentConf.FileSinkMaxFiles = p.FileSinkMaxFiles
 	}
-	agentConf.Blacklist = blacklist
 
 	// DataScrubber
-	agentConf.Scrubber.Enabled = yc.Process.ScrubArgs
-	agentConf.Scrubber.AddCustomSensitiveWords(yc.Process.CustomSensitiveWords)
+	if p.ScrubArgs != nil {
+		agentConf.Scrubber.Enabled = *p.ScrubArgs
+	}
+	agentConf.Scrubber.AddCustomSensitiveWords(p.CustomSensitiveWords)
+	if len(p.ScrubPatterns) > 0 {
+		agentConf.Scrubber.CustomPatterns = compilePatterns(p.ScrubPatterns)
+	}
+	if len(p.ScrubPositionalArgs) > 0 {
+		agentConf.Scrubber.PositionalArgs = p.ScrubPositionalArgs
+	}
+	if p.ScrubURLCredentials != nil {
+		agentConf.Scrubber.ScrubURLCredentials = *p.ScrubURLCredentials
+	}
+	if p.ScrubStructuredArgs != nil {
+		agentConf.Scrubber.ScrubStructuredArgs = *p.ScrubStructuredArgs
+	}
+	if p.ScrubHashValues != nil {
+		agentConf.Scrubber.HashValues = *p.ScrubHashValues
+	}
+	if p.ScrubHashKey != "" {
+		agentConf.Scrubber.HashKey = []byte(p.ScrubHashKey)
+	}
 
-	if yc.Process.QueueSize > 0 {
-		agentConf.QueueSize = yc.Process.QueueSize
+	if p.QueueSize > 0 {
+		agentConf.QueueSize = p.QueueSize
 	}
-	if yc.Process.MaxProcFDs > 0 {
-		agentConf.MaxProcFDs = yc.Process.MaxProcFDs
+	if p.MaxProcFDs > 0 {
+		agentConf.MaxProcFDs = p.MaxProcFDs
 	}
-	if yc.Process.MaxPerMessage > 0 {
-		if yc.Process.MaxPerMessage <= maxProcLimit {
-			agentConf.ProcLimit = yc.Process.MaxPerMessage
+	if p.MaxPerMessage > 0 {
+		if p.MaxPerMessage <= MaxProcLimit {
+			agentConf.ProcLimit = p.MaxPerMessage
 		} else {
 			log.Warn("Overriding the configured process limit because it exceeds maximum")
 		}
 	}
-	agentConf.DDAgentBin = defaultDDAgentBin
-	if yc.Process.DDAgentBin != "" {
-		agentConf.DDAgentBin = yc.Process.DDAgentBin
+	if p.MaxMessageBytes != 0 {
+		agentConf.MaxMessageBytes = p.MaxMessageBytes
+	}
+	if p.ProcessSampleMax != 0 {
+		agentConf.ProcessSampleMax = p.ProcessSampleMax
+	}
+	if p.ProcessSampleTopN != 0 {
+		agentConf.ProcessSampleTopN = p.ProcessSampleTopN
+	}
+	if p.ProcessDelta != nil {
+		agentConf.ProcessDelta = *p.ProcessDelta
+	}
+	if p.ProcessDeltaResync != 0 {
+		agentConf.ProcessDeltaResync = time.Duration(p.ProcessDeltaResync) * time.Second
+	}
+	if p.StatsdHost != "" {
+		agentConf.StatsdHost = p.StatsdHost
+	}
+	if p.StatsdPort != 0 {
+		agentConf.StatsdPort = p.StatsdPort
 	}
 
-	// Pull additional parameters from the global config file.
-	agentConf.LogLevel = ddconfig.Datadog.GetString("log_level")
-	agentConf.StatsdPort = ddconfig.Datadog.GetInt("dogstatsd_port")
-	agentConf.Transport = ddutil.CreateHTTPTransport()
+	// Docker
+	if len(p.ContainerBlacklist) > 0 {
+		agentConf.ContainerBlacklist = p.ContainerBlacklist
+	}
+	if len(p.ContainerWhitelist) > 0 {
+		agentConf.ContainerWhitelist = p.ContainerWhitelist
+	}
+	if p.ContainerLabelWhitelist != nil {
+		agentConf.ContainerLabelWhitelist = p.ContainerLabelWhitelist
+	}
+	if p.ContainerTopProcesses != 0 {
+		agentConf.ContainerTopProcesses = p.ContainerTopProcesses
+	}
+	if p.CollectDockerNetwork != nil {
+		agentConf.CollectDockerNetwork = *p.CollectDockerNetwork
+	}
+	if p.ContainerCacheDuration != 0 {
+		agentConf.ContainerCacheDuration = time.Duration(p.ContainerCacheDuration) * time.Second
+	}
 
-	return agentConf, nil
+	if p.DDAgentBin != "" {
+		agentConf.DDAgentBin = p.DDAgentBin
+	}
+	if p.DDAgentPy != "" {
+		agentConf.DDAgentPy = p.DDAgentPy
+	}
+	if len(p.DDAgentEnv) > 0 {
+		agentConf.DDAgentPyEnv = p.DDAgentEnv
+	}
+	return nil
 }
 
 // SetupDDAgentConfig initializes the datadog-agent config with a YAML file.
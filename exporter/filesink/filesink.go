@@ -0,0 +1,192 @@
+// Package filesink writes check payloads to rotating local files, for hosts
+// without access to the intake and for debugging.
+package filesink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// Payload file formats
+const (
+	// FormatBinary writes each message as a 4-byte big-endian length followed by
+	// the output of model.EncodeMessage.
+	FormatBinary = "binary"
+	// FormatJSON writes each message as a line of JSON.
+	FormatJSON = "json"
+)
+
+// Sink writes payloads to a file in a directory, rotating it once it reaches the
+// maximum size. Rotated files get a numbered suffix, ".1" being the most recent.
+type Sink struct {
+	path     string
+	format   string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// jsonRecord is a single line of a FormatJSON payload file.
+type jsonRecord struct {
+	Endpoint  string            `json:"endpoint"`
+	Type      model.MessageType `json:"type"`
+	Timestamp int64             `json:"timestamp"`
+	Body      json.RawMessage   `json:"body"`
+}
+
+// NewSink returns a Sink configured with the file sink settings of cfg. The
+// directory is created if it doesn't exist.
+func NewSink(cfg *config.AgentConfig) (*Sink, error) {
+	var name string
+	switch cfg.FileSinkFormat {
+	case FormatBinary:
+		name = "payloads.bin"
+	case FormatJSON:
+		name = "payloads.ndjson"
+	default:
+		return nil, fmt.Errorf("unknown file sink format '%s', choose from: %s, %s", cfg.FileSinkFormat, FormatBinary, FormatJSON)
+	}
+	if err := os.MkdirAll(cfg.FileSinkDir, 0755); err != nil {
+		return nil, fmt.Errorf("could not create file sink directory: %s", err)
+	}
+	return &Sink{
+		path:     filepath.Join(cfg.FileSinkDir, name),
+		format:   cfg.FileSinkFormat,
+		maxSize:  cfg.FileSinkMaxSize,
+		maxFiles: cfg.FileSinkMaxFiles,
+	}, nil
+}
+
+// Path returns the path of the file currently written.
+func (s *Sink) Path() string {
+	return s.path
+}
+
+// Write appends the message submitted to the given endpoint to the payload file.
+func (s *Sink) Write(endpoint string, m model.Message) error {
+	record, err := s.encode(endpoint, m)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(record)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	n, err := s.f.Write(record)
+	s.size += int64(n)
+	return err
+}
+
+// Flush commits the payload file to disk.
+func (s *Sink) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	return s.f.Sync()
+}
+
+// Close closes the payload file.
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+func (s *Sink) encode(endpoint string, m model.Message) ([]byte, error) {
+	switch s.format {
+	case FormatJSON:
+		var body bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&body, m.Body); err != nil {
+			return nil, err
+		}
+		record, err := json.Marshal(jsonRecord{
+			Endpoint:  endpoint,
+			Type:      m.Header.Type,
+			Timestamp: m.Header.Timestamp,
+			Body:      body.Bytes(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return append(record, '\n'), nil
+	default:
+		data, err := model.EncodeMessage(m)
+		if err != nil {
+			return nil, err
+		}
+		record := make([]byte, 4+len(data))
+		binary.BigEndian.PutUint32(record, uint32(len(data)))
+		copy(record[4:], data)
+		return record, nil
+	}
+}
+
+// open opens the payload file for appending, resuming an existing file.
+func (s *Sink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, info.Size()
+	return nil
+}
+
+// rotate closes the current file and shifts the numbered files, dropping the
+// oldest one so that at most maxFiles are kept.
+func (s *Sink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f, s.size = nil, 0
+
+	if s.maxFiles <= 1 {
+		return os.Remove(s.path)
+	}
+	os.Remove(fmt.Sprintf("%s.%d", s.path, s.maxFiles-1))
+	for i := s.maxFiles - 2; i >= 1; i-- {
+		from := fmt.Sprintf("%s.%d", s.path, i)
+		if _, err := os.Stat(from); err == nil {
+			if err := os.Rename(from, fmt.Sprintf("%s.%d", s.path, i+1)); err != nil {
+				return err
+			}
+		}
+	}
+	return os.Rename(s.path, s.path+".1")
+}
@@ -0,0 +1,142 @@
+package filesink
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func testConfig(t *testing.T, format string) (*config.AgentConfig, func()) {
+	dir, err := ioutil.TempDir("", "filesink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.NewDefaultAgentConfig()
+	cfg.FileSinkDir = filepath.Join(dir, "payloads")
+	cfg.FileSinkFormat = format
+	return cfg, func() { os.RemoveAll(dir) }
+}
+
+func testMessage(pid int32) model.Message {
+	return model.Message{
+		Header: model.MessageHeader{
+			Version:   model.MessageV3,
+			Encoding:  model.MessageEncodingProtobuf,
+			Type:      model.TypeCollectorProc,
+			Timestamp: 1519898400000,
+		},
+		Body: &model.CollectorProc{
+			HostName:  "my-host",
+			Processes: []*model.Process{{Pid: pid}},
+		},
+	}
+}
+
+func TestSinkBinary(t *testing.T) {
+	cfg, cleanup := testConfig(t, FormatBinary)
+	defer cleanup()
+
+	s, err := NewSink(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Write("/api/v1/collector", testMessage(1)))
+	assert.NoError(t, s.Write("/api/v1/collector", testMessage(2)))
+	assert.NoError(t, s.Close())
+
+	data, err := ioutil.ReadFile(filepath.Join(cfg.FileSinkDir, "payloads.bin"))
+	assert.NoError(t, err)
+	for _, pid := range []int32{1, 2} {
+		expected, err := model.EncodeMessage(testMessage(pid))
+		assert.NoError(t, err)
+		if !assert.True(t, len(data) >= 4) {
+			return
+		}
+		n := binary.BigEndian.Uint32(data)
+		assert.Equal(t, expected, data[4:4+n])
+		data = data[4+n:]
+	}
+	assert.Len(t, data, 0)
+}
+
+func TestSinkJSON(t *testing.T) {
+	cfg, cleanup := testConfig(t, FormatJSON)
+	defer cleanup()
+
+	s, err := NewSink(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Write("/api/v1/collector", testMessage(1)))
+	assert.NoError(t, s.Close())
+
+	// Writes resume on the existing file
+	s, err = NewSink(cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Write("/api/v1/collector", testMessage(2)))
+	assert.NoError(t, s.Close())
+
+	f, err := os.Open(s.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r map[string]interface{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	if !assert.Len(t, records, 2) {
+		return
+	}
+	assert.Equal(t, "/api/v1/collector", records[0]["endpoint"])
+	assert.Equal(t, float64(model.TypeCollectorProc), records[0]["type"])
+	assert.Equal(t, float64(1519898400000), records[0]["timestamp"])
+	body := records[1]["body"].(map[string]interface{})
+	assert.Equal(t, "my-host", body["hostName"])
+	assert.Equal(t, float64(2), body["processes"].([]interface{})[0].(map[string]interface{})["pid"])
+}
+
+func TestSinkRotation(t *testing.T) {
+	cfg, cleanup := testConfig(t, FormatBinary)
+	defer cleanup()
+
+	record, err := model.EncodeMessage(testMessage(1))
+	assert.NoError(t, err)
+	// Two records fit in a file
+	cfg.FileSinkMaxSize = int64(2 * (4 + len(record)))
+	cfg.FileSinkMaxFiles = 3
+
+	s, err := NewSink(cfg)
+	assert.NoError(t, err)
+	for i := 0; i < 9; i++ {
+		assert.NoError(t, s.Write("/api/v1/collector", testMessage(1)))
+	}
+	assert.NoError(t, s.Close())
+
+	files, err := filepath.Glob(filepath.Join(cfg.FileSinkDir, "*"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.Path(), s.Path() + ".1", s.Path() + ".2"}, files)
+
+	for _, f := range files {
+		info, err := os.Stat(f)
+		assert.NoError(t, err)
+		assert.True(t, info.Size() <= cfg.FileSinkMaxSize)
+	}
+}
+
+func TestSinkInvalidFormat(t *testing.T) {
+	cfg, cleanup := testConfig(t, "xml")
+	defer cleanup()
+
+	_, err := NewSink(cfg)
+	assert.Error(t, err)
+}
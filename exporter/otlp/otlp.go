@@ -0,0 +1,353 @@
+// Package otlp converts the process and container check results into OTLP
+// metrics and pushes them to an OpenTelemetry collector over OTLP/HTTP, using
+// the JSON encoding of the protocol.
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	metricsPath = "/v1/metrics"
+	scopeName   = "datadog-process-agent"
+)
+
+// The OTLP JSON encoding of the ExportMetricsServiceRequest, limited to the
+// fields we use. 64-bit integers are encoded as strings as per the protobuf JSON
+// mapping.
+
+// ExportRequest is the body of an OTLP/HTTP metrics export.
+type ExportRequest struct {
+	ResourceMetrics []*ResourceMetrics `json:"resourceMetrics"`
+}
+
+// ResourceMetrics are the metrics of a single resource, e.g. a process or a container.
+type ResourceMetrics struct {
+	Resource     Resource        `json:"resource"`
+	ScopeMetrics []*ScopeMetrics `json:"scopeMetrics"`
+}
+
+// Resource describes the entity the metrics are about.
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// ScopeMetrics are the metrics produced by an instrumentation scope.
+type ScopeMetrics struct {
+	Scope   Scope     `json:"scope"`
+	Metrics []*Metric `json:"metrics"`
+}
+
+// Scope is the instrumentation scope, the process-agent itself.
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Metric is either a gauge or a sum.
+type Metric struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+	Gauge       *Gauge `json:"gauge,omitempty"`
+	Sum         *Sum   `json:"sum,omitempty"`
+}
+
+// Gauge is a metric whose data points are instantaneous values.
+type Gauge struct {
+	DataPoints []*DataPoint `json:"dataPoints"`
+}
+
+// Sum is a metric whose data points are sums over time.
+type Sum struct {
+	DataPoints             []*DataPoint `json:"dataPoints"`
+	AggregationTemporality int          `json:"aggregationTemporality"`
+	IsMonotonic            bool         `json:"isMonotonic"`
+}
+
+// aggregationTemporalityCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE.
+const aggregationTemporalityCumulative = 2
+
+// DataPoint is a single value of a metric.
+type DataPoint struct {
+	Attributes   []KeyValue `json:"attributes,omitempty"`
+	TimeUnixNano string     `json:"timeUnixNano"`
+	AsDouble     *float64   `json:"asDouble,omitempty"`
+	AsInt        string     `json:"asInt,omitempty"`
+}
+
+// KeyValue is an attribute of a resource or a data point.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue holds the value of an attribute.
+type AnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    string  `json:"intValue,omitempty"`
+}
+
+func stringAttr(key, value string) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{StringValue: &value}}
+}
+
+func intAttr(key string, value int64) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{IntValue: strconv.FormatInt(value, 10)}}
+}
+
+// Exporter pushes check results to an OTLP/HTTP endpoint.
+type Exporter struct {
+	url      string
+	hostName string
+	version  string
+	client   http.Client
+}
+
+// NewExporter returns an Exporter pushing to the OTLP endpoint of cfg.
+func NewExporter(cfg *config.AgentConfig, version string) *Exporter {
+	return &Exporter{
+		url:      strings.TrimSuffix(cfg.OTLPEndpoint, "/") + metricsPath,
+		hostName: cfg.HostName,
+		version:  version,
+		client:   http.Client{Transport: cfg.Transport, Timeout: 10 * time.Second},
+	}
+}
+
+// Export converts the messages of a check run and pushes them to the collector.
+// Messages without any process or container data are ignored.
+func (e *Exporter) Export(messages []model.MessageBody) error {
+	req := e.Convert(messages, time.Now())
+	if len(req.ResourceMetrics) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected response from %s. Status: %s, %s", e.url, resp.Status, msg)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// Convert converts the contents of CollectorProc, CollectorRealTime and
+// CollectorContainer messages into an OTLP export request. Each process and
+// container is its own resource, described with the process.* and container.*
+// semantic conventions.
+func (e *Exporter) Convert(messages []model.MessageBody, now time.Time) *ExportRequest {
+	b := &builder{
+		exporter: e,
+		ts:       strconv.FormatInt(now.UnixNano(), 10),
+		req:      &ExportRequest{ResourceMetrics: []*ResourceMetrics{}},
+	}
+	for _, m := range messages {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			for _, p := range msg.Processes {
+				b.addProcess(processAttributes(p), p.Cpu, p.Memory, p.IoStat, p.OpenFdCount,
+					p.VoluntaryCtxSwitches, p.InvoluntaryCtxSwitches)
+			}
+			for _, c := range msg.Containers {
+				b.addContainer(containerAttributes(c), containerStatFromContainer(c))
+			}
+		case *model.CollectorRealTime:
+			for _, s := range msg.Stats {
+				attrs := []KeyValue{intAttr("process.pid", int64(s.Pid))}
+				if s.ContainerId != "" {
+					attrs = append(attrs, stringAttr("container.id", s.ContainerId))
+				}
+				b.addProcess(attrs, s.Cpu, s.Memory, s.IoStat, s.OpenFdCount,
+					s.VoluntaryCtxSwitches, s.InvoluntaryCtxSwitches)
+			}
+			for _, s := range msg.ContainerStats {
+				b.addContainer([]KeyValue{stringAttr("container.id", s.Id)}, s)
+			}
+		case *model.CollectorContainer:
+			for _, c := range msg.Containers {
+				b.addContainer(containerAttributes(c), containerStatFromContainer(c))
+			}
+		}
+	}
+	return b.req
+}
+
+type builder struct {
+	exporter *Exporter
+	ts       string
+	req      *ExportRequest
+}
+
+func (b *builder) resource(attrs []KeyValue) *ScopeMetrics {
+	if b.exporter.hostName != "" {
+		attrs = append([]KeyValue{stringAttr("host.name", b.exporter.hostName)}, attrs...)
+	}
+	sm := &ScopeMetrics{Scope: Scope{Name: scopeName, Version: b.exporter.version}}
+	b.req.ResourceMetrics = append(b.req.ResourceMetrics, &ResourceMetrics{
+		Resource:     Resource{Attributes: attrs},
+		ScopeMetrics: []*ScopeMetrics{sm},
+	})
+	return sm
+}
+
+func (b *builder) doublePoint(v float64, attrs ...KeyValue) *DataPoint {
+	return &DataPoint{Attributes: attrs, TimeUnixNano: b.ts, AsDouble: &v}
+}
+
+func (b *builder) intPoint(v int64, attrs ...KeyValue) *DataPoint {
+	return &DataPoint{Attributes: attrs, TimeUnixNano: b.ts, AsInt: strconv.FormatInt(v, 10)}
+}
+
+func gauge(name, unit, desc string, points ...*DataPoint) *Metric {
+	return &Metric{Name: name, Unit: unit, Description: desc, Gauge: &Gauge{DataPoints: points}}
+}
+
+func counter(name, unit, desc string, points ...*DataPoint) *Metric {
+	return &Metric{Name: name, Unit: unit, Description: desc, Sum: &Sum{
+		DataPoints:             points,
+		AggregationTemporality: aggregationTemporalityCumulative,
+		IsMonotonic:            true,
+	}}
+}
+
+func (b *builder) addProcess(
+	attrs []KeyValue,
+	cpu *model.CPUStat,
+	mem *model.MemoryStat,
+	ioStat *model.IOStat,
+	openFds int32,
+	voluntaryCtx, involuntaryCtx uint64,
+) {
+	sm := b.resource(attrs)
+	if cpu != nil {
+		sm.Metrics = append(sm.Metrics,
+			gauge("process.cpu.utilization", "1", "CPU usage of the process as a ratio of one core.",
+				b.doublePoint(float64(cpu.UserPct)/100, stringAttr("state", "user")),
+				b.doublePoint(float64(cpu.SystemPct)/100, stringAttr("state", "system"))),
+			gauge("process.thread.count", "{thread}", "Number of threads of the process.",
+				b.intPoint(int64(cpu.NumThreads))))
+	}
+	if mem != nil {
+		sm.Metrics = append(sm.Metrics,
+			gauge("process.memory.usage", "By", "Resident set size of the process.", b.intPoint(int64(mem.Rss))),
+			gauge("process.memory.virtual", "By", "Virtual memory size of the process.", b.intPoint(int64(mem.Vms))))
+	}
+	if ioStat != nil {
+		sm.Metrics = append(sm.Metrics,
+			gauge("process.disk.io.rate", "By/s", "Disk bytes transferred per second by the process.",
+				b.doublePoint(float64(ioStat.ReadBytesRate), stringAttr("direction", "read")),
+				b.doublePoint(float64(ioStat.WriteBytesRate), stringAttr("direction", "write"))))
+	}
+	// A negative count means we couldn't read the file descriptors.
+	if openFds >= 0 {
+		sm.Metrics = append(sm.Metrics,
+			gauge("process.open_file_descriptor.count", "{count}", "Number of file descriptors opened by the process.",
+				b.intPoint(int64(openFds))))
+	}
+	sm.Metrics = append(sm.Metrics,
+		counter("process.context_switches", "{count}", "Number of times the process has been context switched.",
+			b.intPoint(int64(voluntaryCtx), stringAttr("type", "voluntary")),
+			b.intPoint(int64(involuntaryCtx), stringAttr("type", "involuntary"))))
+}
+
+func (b *builder) addContainer(attrs []KeyValue, s *model.ContainerStat) {
+	sm := b.resource(attrs)
+	sm.Metrics = append(sm.Metrics,
+		gauge("container.cpu.utilization", "1", "CPU usage of the container as a ratio of one core.",
+			b.doublePoint(float64(s.UserPct)/100, stringAttr("state", "user")),
+			b.doublePoint(float64(s.SystemPct)/100, stringAttr("state", "system"))),
+		gauge("container.memory.usage", "By", "Resident set size of the container.", b.intPoint(int64(s.MemRss))),
+		gauge("container.memory.cache", "By", "Page cache used by the container.", b.intPoint(int64(s.MemCache))),
+		gauge("container.disk.io.rate", "By/s", "Disk bytes transferred per second by the container.",
+			b.doublePoint(float64(s.Rbps), stringAttr("direction", "read")),
+			b.doublePoint(float64(s.Wbps), stringAttr("direction", "write"))),
+		gauge("container.network.io.rate", "By/s", "Network bytes transferred per second by the container.",
+			b.doublePoint(float64(s.NetRcvdBps), stringAttr("direction", "receive")),
+			b.doublePoint(float64(s.NetSentBps), stringAttr("direction", "transmit"))),
+	)
+	if s.CpuLimit > 0 {
+		sm.Metrics = append(sm.Metrics,
+			gauge("container.cpu.limit", "1", "CPU limit of the container as a ratio of one core.",
+				b.doublePoint(float64(s.CpuLimit)/100)))
+	}
+	if s.MemLimit > 0 {
+		sm.Metrics = append(sm.Metrics,
+			gauge("container.memory.limit", "By", "Memory limit of the container.", b.intPoint(int64(s.MemLimit))))
+	}
+}
+
+func processAttributes(p *model.Process) []KeyValue {
+	attrs := []KeyValue{intAttr("process.pid", int64(p.Pid))}
+	if cmd := p.Command; cmd != nil {
+		attrs = append(attrs, intAttr("process.parent_pid", int64(cmd.Ppid)))
+		if name := checks.ProcessName(cmd); name != "" {
+			attrs = append(attrs, stringAttr("process.executable.name", name))
+		}
+		if cmd.Exe != "" {
+			attrs = append(attrs, stringAttr("process.executable.path", cmd.Exe))
+		}
+		if len(cmd.Args) > 0 {
+			attrs = append(attrs, stringAttr("process.command_line", strings.Join(cmd.Args, " ")))
+		}
+	}
+	if p.User != nil && p.User.Name != "" {
+		attrs = append(attrs, stringAttr("process.owner", p.User.Name))
+	}
+	if p.ContainerId != "" {
+		attrs = append(attrs, stringAttr("container.id", p.ContainerId))
+	}
+	return attrs
+}
+
+func containerAttributes(c *model.Container) []KeyValue {
+	attrs := []KeyValue{stringAttr("container.id", c.Id)}
+	if c.Type != "" {
+		attrs = append(attrs, stringAttr("container.runtime", c.Type))
+	}
+	if c.ImageName != "" {
+		attrs = append(attrs, stringAttr("container.image.name", c.ImageName))
+	}
+	if c.ImageTag != "" {
+		attrs = append(attrs, stringAttr("container.image.tag", c.ImageTag))
+	}
+	return attrs
+}
+
+// containerStatFromContainer extracts the statistics of a container so that
+// they're converted the same way as the real-time ones.
+func containerStatFromContainer(c *model.Container) *model.ContainerStat {
+	return &model.ContainerStat{
+		Id:         c.Id,
+		UserPct:    c.UserPct,
+		SystemPct:  c.SystemPct,
+		TotalPct:   c.TotalPct,
+		CpuLimit:   c.CpuLimit,
+		MemRss:     c.MemRss,
+		MemCache:   c.MemCache,
+		MemLimit:   c.MemoryLimit,
+		Rbps:       c.Rbps,
+		Wbps:       c.Wbps,
+		NetRcvdBps: c.NetRcvdBps,
+		NetSentBps: c.NetSentBps,
+	}
+}
@@ -0,0 +1,208 @@
+package otlp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCollector is a minimal OTLP/HTTP collector recording the metrics it receives.
+type fakeCollector struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*ExportRequest
+	status   int
+}
+
+func newFakeCollector(t *testing.T) *fakeCollector {
+	c := &fakeCollector{status: http.StatusOK}
+	c.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, metricsPath, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var req ExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid export request: %s", err)
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.requests = append(c.requests, &req)
+		w.WriteHeader(c.status)
+		w.Write([]byte("{}"))
+	}))
+	return c
+}
+
+func attr(attrs []KeyValue, key string) string {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			if kv.Value.StringValue != nil {
+				return *kv.Value.StringValue
+			}
+			return kv.Value.IntValue
+		}
+	}
+	return ""
+}
+
+func findMetric(rm *ResourceMetrics, name string) *Metric {
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	collector := newFakeCollector(t)
+	defer collector.Close()
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.HostName = "my-host"
+	cfg.OTLPEndpoint = collector.URL + "/"
+	e := NewExporter(cfg, "5.24.0")
+
+	err := e.Export([]model.MessageBody{
+		&model.CollectorProc{
+			Processes: []*model.Process{{
+				Pid:                  42,
+				Command:              &model.Command{Args: []string{"/usr/bin/redis-server", "*:6379"}, Exe: "/usr/bin/redis-server", Ppid: 1},
+				User:                 &model.ProcessUser{Name: "redis"},
+				Cpu:                  &model.CPUStat{UserPct: 50, SystemPct: 25, NumThreads: 4},
+				Memory:               &model.MemoryStat{Rss: 1024, Vms: 4096},
+				IoStat:               &model.IOStat{ReadBytesRate: 10, WriteBytesRate: 20},
+				OpenFdCount:          8,
+				ContainerId:          "abc",
+				VoluntaryCtxSwitches: 100,
+			}},
+			Containers: []*model.Container{{
+				Id:          "abc",
+				Type:        "docker",
+				ImageName:   "redis",
+				ImageTag:    "4",
+				UserPct:     50,
+				MemRss:      2048,
+				MemoryLimit: 8192,
+				NetRcvdBps:  30,
+			}},
+		},
+		// Messages without process or container data are ignored
+		&model.CollectorConnections{},
+	})
+	assert.NoError(t, err)
+
+	if !assert.Len(t, collector.requests, 1) {
+		return
+	}
+	rms := collector.requests[0].ResourceMetrics
+	if !assert.Len(t, rms, 2) {
+		return
+	}
+
+	proc := rms[0]
+	assert.Equal(t, "my-host", attr(proc.Resource.Attributes, "host.name"))
+	assert.Equal(t, "42", attr(proc.Resource.Attributes, "process.pid"))
+	assert.Equal(t, "1", attr(proc.Resource.Attributes, "process.parent_pid"))
+	assert.Equal(t, "redis-server", attr(proc.Resource.Attributes, "process.executable.name"))
+	assert.Equal(t, "/usr/bin/redis-server", attr(proc.Resource.Attributes, "process.executable.path"))
+	assert.Equal(t, "/usr/bin/redis-server *:6379", attr(proc.Resource.Attributes, "process.command_line"))
+	assert.Equal(t, "redis", attr(proc.Resource.Attributes, "process.owner"))
+	assert.Equal(t, "abc", attr(proc.Resource.Attributes, "container.id"))
+	assert.Equal(t, scopeName, proc.ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, "5.24.0", proc.ScopeMetrics[0].Scope.Version)
+
+	cpu := findMetric(proc, "process.cpu.utilization")
+	if assert.NotNil(t, cpu) && assert.Len(t, cpu.Gauge.DataPoints, 2) {
+		assert.Equal(t, "user", attr(cpu.Gauge.DataPoints[0].Attributes, "state"))
+		assert.Equal(t, 0.5, *cpu.Gauge.DataPoints[0].AsDouble)
+		assert.Equal(t, 0.25, *cpu.Gauge.DataPoints[1].AsDouble)
+	}
+	mem := findMetric(proc, "process.memory.usage")
+	if assert.NotNil(t, mem) {
+		assert.Equal(t, "By", mem.Unit)
+		assert.Equal(t, "1024", mem.Gauge.DataPoints[0].AsInt)
+	}
+	fds := findMetric(proc, "process.open_file_descriptor.count")
+	if assert.NotNil(t, fds) {
+		assert.Equal(t, "8", fds.Gauge.DataPoints[0].AsInt)
+	}
+	ctx := findMetric(proc, "process.context_switches")
+	if assert.NotNil(t, ctx) && assert.NotNil(t, ctx.Sum) {
+		assert.True(t, ctx.Sum.IsMonotonic)
+		assert.Equal(t, aggregationTemporalityCumulative, ctx.Sum.AggregationTemporality)
+		assert.Equal(t, "100", ctx.Sum.DataPoints[0].AsInt)
+	}
+
+	ctr := rms[1]
+	assert.Equal(t, "abc", attr(ctr.Resource.Attributes, "container.id"))
+	assert.Equal(t, "docker", attr(ctr.Resource.Attributes, "container.runtime"))
+	assert.Equal(t, "redis", attr(ctr.Resource.Attributes, "container.image.name"))
+	assert.Equal(t, "4", attr(ctr.Resource.Attributes, "container.image.tag"))
+	limit := findMetric(ctr, "container.memory.limit")
+	if assert.NotNil(t, limit) {
+		assert.Equal(t, "8192", limit.Gauge.DataPoints[0].AsInt)
+	}
+	net := findMetric(ctr, "container.network.io.rate")
+	if assert.NotNil(t, net) {
+		assert.Equal(t, "receive", attr(net.Gauge.DataPoints[0].Attributes, "direction"))
+		assert.Equal(t, float64(30), *net.Gauge.DataPoints[0].AsDouble)
+	}
+	// No CPU limit was set
+	assert.Nil(t, findMetric(ctr, "container.cpu.limit"))
+}
+
+func TestConvertRealTime(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	e := NewExporter(cfg, "")
+	now := time.Unix(1519898400, 0)
+
+	req := e.Convert([]model.MessageBody{&model.CollectorRealTime{
+		Stats: []*model.ProcessStat{
+			{Pid: 1, Memory: &model.MemoryStat{Rss: 10}, OpenFdCount: -1},
+		},
+		ContainerStats: []*model.ContainerStat{
+			{Id: "abc", CpuLimit: 200},
+		},
+	}}, now)
+
+	if !assert.Len(t, req.ResourceMetrics, 2) {
+		return
+	}
+	proc := req.ResourceMetrics[0]
+	assert.Equal(t, "", attr(proc.Resource.Attributes, "host.name"))
+	assert.Equal(t, "1", attr(proc.Resource.Attributes, "process.pid"))
+	// Unreadable file descriptors aren't reported
+	assert.Nil(t, findMetric(proc, "process.open_file_descriptor.count"))
+	assert.Nil(t, findMetric(proc, "process.cpu.utilization"))
+	mem := findMetric(proc, "process.memory.usage")
+	if assert.NotNil(t, mem) {
+		assert.Equal(t, "1519898400000000000", mem.Gauge.DataPoints[0].TimeUnixNano)
+	}
+
+	limit := findMetric(req.ResourceMetrics[1], "container.cpu.limit")
+	if assert.NotNil(t, limit) {
+		assert.Equal(t, float64(2), *limit.Gauge.DataPoints[0].AsDouble)
+	}
+}
+
+func TestExportError(t *testing.T) {
+	collector := newFakeCollector(t)
+	defer collector.Close()
+	collector.status = http.StatusBadRequest
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.OTLPEndpoint = collector.URL
+	e := NewExporter(cfg, "")
+
+	err := e.Export([]model.MessageBody{&model.CollectorContainer{
+		Containers: []*model.Container{{Id: "abc"}},
+	}})
+	assert.Error(t, err)
+}
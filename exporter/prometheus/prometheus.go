@@ -0,0 +1,322 @@
+// Package prometheus exposes the latest process and container check results in
+// the Prometheus text exposition format.
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+const (
+	namespace   = "dd"
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// ProcessLabels are the labels that can be attached to process metrics. Processes
+// with the same values for the configured labels are summed into a single series,
+// so dropping "pid" is the main way to bound cardinality.
+var ProcessLabels = []string{"pid", "name", "user", "container_id", "cmdline"}
+
+type metric struct {
+	name string
+	help string
+}
+
+var (
+	processMetrics = []metric{
+		{"process_cpu_total_pct", "Total CPU usage of the process in percent of a core."},
+		{"process_cpu_user_pct", "User CPU usage of the process in percent of a core."},
+		{"process_cpu_system_pct", "System CPU usage of the process in percent of a core."},
+		{"process_memory_rss_bytes", "Resident set size of the process."},
+		{"process_memory_vms_bytes", "Virtual memory size of the process."},
+		{"process_io_read_bytes_per_second", "Bytes read per second by the process."},
+		{"process_io_write_bytes_per_second", "Bytes written per second by the process."},
+		{"process_open_fds", "Number of file descriptors opened by the process."},
+		{"process_threads", "Number of threads of the process."},
+		{"process_count", "Number of processes aggregated in the series."},
+	}
+	containerMetrics = []metric{
+		{"container_cpu_total_pct", "Total CPU usage of the container in percent of a core."},
+		{"container_cpu_user_pct", "User CPU usage of the container in percent of a core."},
+		{"container_cpu_system_pct", "System CPU usage of the container in percent of a core."},
+		{"container_cpu_limit_pct", "CPU limit of the container in percent of a core."},
+		{"container_memory_rss_bytes", "Resident set size of the container."},
+		{"container_memory_cache_bytes", "Page cache used by the container."},
+		{"container_memory_limit_bytes", "Memory limit of the container."},
+		{"container_io_read_bytes_per_second", "Bytes read per second by the container."},
+		{"container_io_write_bytes_per_second", "Bytes written per second by the container."},
+		{"container_net_rcvd_bytes_per_second", "Bytes received per second by the container."},
+		{"container_net_sent_bytes_per_second", "Bytes sent per second by the container."},
+	}
+)
+
+// Exporter keeps the latest process and container check results and renders
+// them when scraped.
+type Exporter struct {
+	labels     []string
+	include    []*regexp.Regexp
+	exclude    []*regexp.Regexp
+	maxProcs   int
+	mu         sync.RWMutex
+	processes  []*model.Process
+	containers []*model.Container
+}
+
+// NewExporter returns an Exporter configured with the Prometheus settings of cfg.
+func NewExporter(cfg *config.AgentConfig) *Exporter {
+	for _, l := range cfg.PrometheusProcessLabels {
+		if !util.StringInSlice(ProcessLabels, l) {
+			log.Warnf("Unknown Prometheus process label '%s', choose from: %v", l, ProcessLabels)
+		}
+	}
+	return &Exporter{
+		labels:   cfg.PrometheusProcessLabels,
+		include:  cfg.PrometheusProcessInclude,
+		exclude:  cfg.PrometheusProcessExclude,
+		maxProcs: cfg.PrometheusMaxProcesses,
+	}
+}
+
+// Update replaces the stored results with the messages of a single check run.
+// Results are only replaced by the kind of check that produces them so that the
+// real-time and connections checks don't affect the exposed metrics.
+func (e *Exporter) Update(messages []model.MessageBody) {
+	var procs []*model.Process
+	var ctrs []*model.Container
+	hasProcs, hasCtrs := false, false
+	for _, m := range messages {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			hasProcs, hasCtrs = true, true
+			procs = append(procs, msg.Processes...)
+			ctrs = append(ctrs, msg.Containers...)
+		case *model.CollectorContainer:
+			hasCtrs = true
+			ctrs = append(ctrs, msg.Containers...)
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if hasProcs {
+		e.processes = procs
+	}
+	if hasCtrs {
+		e.containers = ctrs
+	}
+}
+
+// ServeHTTP renders the stored results in the Prometheus text format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	e.Write(&buf)
+	w.Header().Set("Content-Type", contentType)
+	w.Write(buf.Bytes())
+}
+
+// Write renders the stored results in the Prometheus text format to w.
+func (e *Exporter) Write(w io.Writer) {
+	e.mu.RLock()
+	procs, ctrs := e.processes, e.containers
+	e.mu.RUnlock()
+
+	writeSeries(w, processMetrics, e.processSeries(procs))
+	writeSeries(w, containerMetrics, containerSeries(ctrs))
+}
+
+// series is a set of label values along with one value per metric.
+type series struct {
+	labels [][2]string
+	values []float64
+}
+
+func (s *series) key() string {
+	var b bytes.Buffer
+	for _, l := range s.labels {
+		b.WriteString(l[0])
+		b.WriteByte(0)
+		b.WriteString(l[1])
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+// processSeries selects the processes matching the include and exclude rules,
+// keeps the top ones by CPU and sums the processes sharing the same labels.
+func (e *Exporter) processSeries(procs []*model.Process) []*series {
+	selected := make([]*model.Process, 0, len(procs))
+	for _, p := range procs {
+		cmdline := processCmdline(p)
+		if len(e.include) > 0 && !matchAny(e.include, cmdline) {
+			continue
+		}
+		if matchAny(e.exclude, cmdline) {
+			continue
+		}
+		selected = append(selected, p)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return processCPU(selected[i]) > processCPU(selected[j])
+	})
+	if e.maxProcs > 0 && len(selected) > e.maxProcs {
+		selected = selected[:e.maxProcs]
+	}
+
+	byKey := make(map[string]*series, len(selected))
+	all := make([]*series, 0, len(selected))
+	for _, p := range selected {
+		s := &series{labels: e.processLabels(p)}
+		values := processValues(p)
+		if existing, ok := byKey[s.key()]; ok {
+			for i, v := range values {
+				existing.values[i] += v
+			}
+			continue
+		}
+		s.values = values
+		byKey[s.key()] = s
+		all = append(all, s)
+	}
+	return all
+}
+
+func (e *Exporter) processLabels(p *model.Process) [][2]string {
+	labels := make([][2]string, 0, len(e.labels))
+	for _, l := range e.labels {
+		var v string
+		switch l {
+		case "pid":
+			v = strconv.Itoa(int(p.Pid))
+		case "name":
+			v = checks.ProcessName(p.Command)
+		case "user":
+			if p.User != nil {
+				v = p.User.Name
+			}
+		case "container_id":
+			v = p.ContainerId
+		case "cmdline":
+			v = processCmdline(p)
+		default:
+			continue
+		}
+		labels = append(labels, [2]string{l, v})
+	}
+	return labels
+}
+
+func processValues(p *model.Process) []float64 {
+	v := make([]float64, len(processMetrics))
+	if cpu := p.GetCpu(); cpu != nil {
+		v[0] = float64(cpu.TotalPct)
+		v[1] = float64(cpu.UserPct)
+		v[2] = float64(cpu.SystemPct)
+		v[8] = float64(cpu.NumThreads)
+	}
+	if mem := p.GetMemory(); mem != nil {
+		v[3] = float64(mem.Rss)
+		v[4] = float64(mem.Vms)
+	}
+	if ioStat := p.GetIoStat(); ioStat != nil {
+		v[5] = float64(ioStat.ReadBytesRate)
+		v[6] = float64(ioStat.WriteBytesRate)
+	}
+	if p.OpenFdCount > 0 {
+		v[7] = float64(p.OpenFdCount)
+	}
+	v[9] = 1
+	return v
+}
+
+func containerSeries(ctrs []*model.Container) []*series {
+	all := make([]*series, 0, len(ctrs))
+	for _, c := range ctrs {
+		all = append(all, &series{
+			labels: [][2]string{
+				{"container_id", c.Id},
+				{"container_type", c.Type},
+				{"image_name", c.ImageName},
+				{"image_tag", c.ImageTag},
+			},
+			values: []float64{
+				float64(c.TotalPct),
+				float64(c.UserPct),
+				float64(c.SystemPct),
+				float64(c.CpuLimit),
+				float64(c.MemRss),
+				float64(c.MemCache),
+				float64(c.MemoryLimit),
+				float64(c.Rbps),
+				float64(c.Wbps),
+				float64(c.NetRcvdBps),
+				float64(c.NetSentBps),
+			},
+		})
+	}
+	return all
+}
+
+func writeSeries(w io.Writer, metrics []metric, all []*series) {
+	if len(all) == 0 {
+		return
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].key() < all[j].key() })
+	for i, m := range metrics {
+		name := namespace + "_" + m.name
+		fmt.Fprintf(w, "# HELP %s %s\n", name, m.help)
+		fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+		for _, s := range all {
+			fmt.Fprintf(w, "%s%s %s\n", name, formatLabels(s.labels), strconv.FormatFloat(s.values[i], 'g', -1, 64))
+		}
+	}
+}
+
+func formatLabels(labels [][2]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(labels))
+	for _, l := range labels {
+		parts = append(parts, l[0]+`="`+labelEscaper.Replace(l[1])+`"`)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func matchAny(patterns []*regexp.Regexp, s string) bool {
+	for _, p := range patterns {
+		if p.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func processCPU(p *model.Process) float32 {
+	if p.Cpu == nil {
+		return 0
+	}
+	return p.Cpu.TotalPct
+}
+
+func processCmdline(p *model.Process) string {
+	if p.Command == nil {
+		return ""
+	}
+	return strings.Join(p.Command.Args, " ")
+}
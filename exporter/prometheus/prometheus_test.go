@@ -0,0 +1,106 @@
+package prometheus
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeProcess(pid int32, user, cmdline string, cpu float32, rss uint64) *model.Process {
+	return &model.Process{
+		Pid:         pid,
+		Command:     &model.Command{Args: strings.Split(cmdline, " ")},
+		User:        &model.ProcessUser{Name: user},
+		Cpu:         &model.CPUStat{TotalPct: cpu, NumThreads: 2},
+		Memory:      &model.MemoryStat{Rss: rss},
+		IoStat:      &model.IOStat{ReadBytesRate: 10},
+		OpenFdCount: 4,
+	}
+}
+
+func testMessages() []model.MessageBody {
+	return []model.MessageBody{
+		&model.CollectorProc{
+			Processes: []*model.Process{
+				makeProcess(1, "root", "/sbin/init", 0.5, 100),
+				makeProcess(2, "www", "nginx: worker", 10, 200),
+				makeProcess(3, "www", "nginx: worker", 20, 300),
+			},
+			Containers: []*model.Container{
+				{Id: "abc", Type: "docker", ImageName: "nginx", ImageTag: "1.15", TotalPct: 30, MemRss: 500},
+			},
+		},
+		&model.CollectorProc{
+			Processes: []*model.Process{
+				makeProcess(4, "root", "/usr/bin/python -c \"print\"", 5, 400),
+			},
+		},
+	}
+}
+
+func TestExporterPerProcess(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.PrometheusProcessLabels = []string{"pid", "name"}
+	e := NewExporter(cfg)
+	e.Update(testMessages())
+
+	var buf bytes.Buffer
+	e.Write(&buf)
+	out := buf.String()
+
+	assert.Contains(t, out, "# TYPE dd_process_cpu_total_pct gauge\n")
+	assert.Contains(t, out, `dd_process_cpu_total_pct{pid="3",name="nginx:"} 20`+"\n")
+	assert.Contains(t, out, `dd_process_memory_rss_bytes{pid="1",name="init"} 100`+"\n")
+	assert.Contains(t, out, `dd_process_open_fds{pid="4",name="python"} 4`+"\n")
+	assert.Contains(t, out, `dd_container_cpu_total_pct{container_id="abc",container_type="docker",image_name="nginx",image_tag="1.15"} 30`+"\n")
+	assert.Contains(t, out, `dd_container_memory_rss_bytes{container_id="abc",container_type="docker",image_name="nginx",image_tag="1.15"} 500`+"\n")
+}
+
+func TestExporterCardinality(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.PrometheusProcessLabels = []string{"user", "cmdline"}
+	cfg.PrometheusProcessExclude = []*regexp.Regexp{regexp.MustCompile("^/sbin/init")}
+	e := NewExporter(cfg)
+	e.Update(testMessages())
+
+	var buf bytes.Buffer
+	e.Write(&buf)
+	out := buf.String()
+
+	// Both nginx workers are summed into a single series
+	assert.Contains(t, out, `dd_process_cpu_total_pct{user="www",cmdline="nginx: worker"} 30`+"\n")
+	assert.Contains(t, out, `dd_process_threads{user="www",cmdline="nginx: worker"} 4`+"\n")
+	assert.Contains(t, out, `dd_process_count{user="www",cmdline="nginx: worker"} 2`+"\n")
+	// Label values are escaped
+	assert.Contains(t, out, `dd_process_count{user="root",cmdline="/usr/bin/python -c \"print\""} 1`+"\n")
+	assert.NotContains(t, out, "/sbin/init")
+}
+
+func TestExporterSelection(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.PrometheusProcessLabels = []string{"pid"}
+	cfg.PrometheusProcessInclude = []*regexp.Regexp{regexp.MustCompile("nginx|python")}
+	cfg.PrometheusMaxProcesses = 2
+	e := NewExporter(cfg)
+	e.Update(testMessages())
+
+	// A run without processes doesn't reset them
+	e.Update([]model.MessageBody{&model.CollectorConnections{}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	out := rec.Body.String()
+
+	assert.Equal(t, contentType, rec.Header().Get("Content-Type"))
+	assert.Contains(t, out, `dd_process_count{pid="2"} 1`)
+	assert.Contains(t, out, `dd_process_count{pid="3"} 1`)
+	// Only the top 2 processes by CPU are kept
+	assert.NotContains(t, out, `dd_process_count{pid="4"}`)
+	assert.NotContains(t, out, `dd_process_count{pid="1"}`)
+}
@@ -0,0 +1,165 @@
+// Package fakeintake provides an in-process intake for tests. It decodes and
+// records the payloads submitted by the agent and replies with scripted statuses.
+package fakeintake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// Payload is a message received by the Intake.
+type Payload struct {
+	Endpoint string
+	Header   http.Header
+	Message  model.Message
+}
+
+// Intake is an HTTP server behaving like the process intake.
+type Intake struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	payloads []Payload
+	errors   []error
+	statuses []*model.CollectorStatus
+	rejects  []string
+	received chan struct{}
+}
+
+// New starts a new Intake. It replies to every payload with a status without
+// any active client until ReplyWith is called.
+func New() *Intake {
+	i := &Intake{
+		statuses: []*model.CollectorStatus{{ActiveClients: 0, Interval: 2}},
+		received: make(chan struct{}, 1),
+	}
+	i.server = httptest.NewServer(http.HandlerFunc(i.handle))
+	return i
+}
+
+// URL returns the base URL of the Intake.
+func (i *Intake) URL() *url.URL {
+	u, _ := url.Parse(i.server.URL)
+	return u
+}
+
+// Close shuts down the Intake.
+func (i *Intake) Close() {
+	i.server.Close()
+}
+
+// ReplyWith scripts the statuses returned for the next payloads, one per
+// payload. The last status keeps being returned once the script is exhausted.
+func (i *Intake) ReplyWith(statuses ...*model.CollectorStatus) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.statuses = statuses
+}
+
+// RejectWith scripts the rejection of the next payloads, one per payload, the
+// intake replying with the message and without status. The statuses scripted by
+// ReplyWith are returned once the rejections are exhausted.
+func (i *Intake) RejectWith(messages ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.rejects = append(i.rejects, messages...)
+}
+
+// Payloads returns the payloads received so far.
+func (i *Intake) Payloads() []Payload {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]Payload(nil), i.payloads...)
+}
+
+// Errors returns the errors met while decoding the received payloads.
+func (i *Intake) Errors() []error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]error(nil), i.errors...)
+}
+
+// WaitFor waits until a payload received after index from satisfies match and
+// returns its index, or an error once the timeout expires.
+func (i *Intake) WaitFor(from int, timeout time.Duration, match func(Payload) bool) (int, error) {
+	deadline := time.After(timeout)
+	for {
+		payloads := i.Payloads()
+		for idx := from; idx < len(payloads); idx++ {
+			if match(payloads[idx]) {
+				return idx, nil
+			}
+		}
+		select {
+		case <-i.received:
+		case <-deadline:
+			return 0, fmt.Errorf("no matching payload received in %s", timeout)
+		}
+	}
+}
+
+func (i *Intake) handle(w http.ResponseWriter, r *http.Request) {
+	var m model.Message
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		m, err = model.DecodeMessage(body)
+	}
+	if err != nil {
+		i.mu.Lock()
+		i.errors = append(i.errors, err)
+		i.mu.Unlock()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	i.record(Payload{Endpoint: r.URL.Path, Header: r.Header, Message: m})
+
+	res, err := model.EncodeMessage(model.Message{
+		Header: model.MessageHeader{
+			Version:  model.MessageV3,
+			Encoding: model.MessageEncodingProtobuf,
+			Type:     model.TypeResCollector,
+		},
+		Body: i.nextResponse(),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(res)
+}
+
+func (i *Intake) record(p Payload) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.payloads = append(i.payloads, p)
+	// Wake up a waiting WaitFor without blocking if nobody waits.
+	select {
+	case i.received <- struct{}{}:
+	default:
+	}
+}
+
+func (i *Intake) nextResponse() *model.ResCollector {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(i.rejects) > 0 {
+		msg := i.rejects[0]
+		i.rejects = i.rejects[1:]
+		return &model.ResCollector{Message: msg}
+	}
+	if len(i.statuses) == 0 {
+		return &model.ResCollector{Status: &model.CollectorStatus{Interval: 2}}
+	}
+	s := i.statuses[0]
+	if len(i.statuses) > 1 {
+		i.statuses = i.statuses[1:]
+	}
+	return &model.ResCollector{Status: s}
+}
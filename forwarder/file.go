@@ -0,0 +1,36 @@
+package forwarder
+
+import (
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/exporter/filesink"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// FileForwarder writes the payloads to rotating local files.
+type FileForwarder struct {
+	statusTracker
+	sink *filesink.Sink
+}
+
+// NewFileForwarder returns a FileForwarder writing with the file sink settings of cfg.
+func NewFileForwarder(cfg *config.AgentConfig) (*FileForwarder, error) {
+	sink, err := filesink.NewSink(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &FileForwarder{sink: sink}, nil
+}
+
+// Name returns the name of the FileForwarder.
+func (f *FileForwarder) Name() string { return "file " + f.sink.Path() }
+
+// Submit appends the message to the payload file.
+func (f *FileForwarder) Submit(endpoint string, m model.Message) error {
+	return f.record(f.sink.Write(endpoint, m))
+}
+
+// Flush commits the payload file to disk.
+func (f *FileForwarder) Flush() error { return f.sink.Flush() }
+
+// Close closes the payload file.
+func (f *FileForwarder) Close() error { return f.sink.Close() }
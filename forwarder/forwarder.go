@@ -0,0 +1,63 @@
+// Package forwarder submits the encoded check payloads to their destinations.
+package forwarder
+
+import (
+	"sync"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// Forwarder is a destination for the check payloads. Submit is only called from
+// a single routine but Status may be read concurrently.
+type Forwarder interface {
+	// Name identifies the forwarder in logs.
+	Name() string
+	// Submit sends a message produced by a check for the given endpoint.
+	Submit(endpoint string, m model.Message) error
+	// Flush makes sure the submitted messages reached their destination.
+	Flush() error
+	// Close flushes the forwarder and releases its resources.
+	Close() error
+	// Status returns the submission statistics of the forwarder.
+	Status() Status
+}
+
+// Status holds the submission statistics of a Forwarder.
+type Status struct {
+	Submitted int64
+	Errors    int64
+	LastError string
+	// The latest status returned by the intake, nil for destinations without one.
+	CollectorStatus *model.CollectorStatus
+}
+
+// statusTracker records the outcome of submissions, it is shared by the
+// Forwarder implementations.
+type statusTracker struct {
+	mu     sync.RWMutex
+	status Status
+}
+
+func (t *statusTracker) record(err error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.Submitted++
+	if err != nil {
+		t.status.Errors++
+		t.status.LastError = err.Error()
+	}
+	return err
+}
+
+func (t *statusTracker) setCollectorStatus(s *model.CollectorStatus) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.CollectorStatus = s
+}
+
+// Status returns the submission statistics.
+func (t *statusTracker) Status() Status {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.status
+}
@@ -0,0 +1,112 @@
+package forwarder
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/forwarder/fakeintake"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func testMessage() model.Message {
+	return model.Message{
+		Header: model.MessageHeader{
+			Version:  model.MessageV3,
+			Encoding: model.MessageEncodingZstdPB,
+			Type:     model.TypeCollectorProc,
+		},
+		Body: &model.CollectorProc{HostName: "my-host"},
+	}
+}
+
+func TestHTTPForwarder(t *testing.T) {
+	intake := fakeintake.New()
+	defer intake.Close()
+	intake.ReplyWith(
+		&model.CollectorStatus{ActiveClients: 1, Interval: 2},
+		&model.CollectorStatus{ActiveClients: 0, Interval: 10},
+	)
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoint = intake.URL()
+	cfg.APIKey = "apikey"
+	cfg.HostName = "my-host"
+	f := NewHTTPForwarder(cfg, "5.24.0")
+
+	assert.NoError(t, f.Submit("/api/v1/collector", testMessage()))
+	assert.Equal(t, int32(1), f.Status().CollectorStatus.ActiveClients)
+	assert.NoError(t, f.Submit("/api/v1/collector", testMessage()))
+	assert.Equal(t, int32(0), f.Status().CollectorStatus.ActiveClients)
+	assert.Equal(t, int32(10), f.Status().CollectorStatus.Interval)
+
+	// The intake rejected the payload, it's reported and not retried, and the
+	// previous status is kept
+	intake.RejectWith("invalid payload")
+	assert.EqualError(t, f.Submit("/api/v1/collector", testMessage()), "invalid payload")
+
+	status := f.Status()
+	assert.Equal(t, int64(3), status.Submitted)
+	assert.Equal(t, int64(1), status.Errors)
+	assert.Equal(t, "invalid payload", status.LastError)
+	assert.Equal(t, int32(10), status.CollectorStatus.Interval)
+
+	payloads := intake.Payloads()
+	if !assert.Len(t, payloads, 3) {
+		return
+	}
+	assert.Equal(t, "/api/v1/collector", payloads[0].Endpoint)
+	assert.Equal(t, "apikey", payloads[0].Header.Get("X-Dd-APIKey"))
+	assert.Equal(t, "my-host", payloads[0].Header.Get("X-Dd-Hostname"))
+	assert.Equal(t, "5.24.0", payloads[0].Header.Get("X-Dd-Processagentversion"))
+	assert.Equal(t, "my-host", payloads[0].Message.Body.(*model.CollectorProc).HostName)
+	assert.NoError(t, f.Flush())
+	assert.NoError(t, f.Close())
+}
+
+func TestHTTPForwarderErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoint, _ = url.Parse(srv.URL)
+	f := NewHTTPForwarder(cfg, "")
+
+	assert.Error(t, f.Submit("/api/v1/collector", testMessage()))
+	status := f.Status()
+	assert.Equal(t, int64(1), status.Submitted)
+	assert.Equal(t, int64(1), status.Errors)
+	assert.Contains(t, status.LastError, "500 Internal Server Error")
+	assert.Nil(t, status.CollectorStatus)
+}
+
+func TestFileForwarder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "forwarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.FileSinkDir = dir
+	f, err := NewFileForwarder(cfg)
+	assert.NoError(t, err)
+
+	assert.NoError(t, f.Submit("/api/v1/collector", testMessage()))
+	assert.NoError(t, f.Flush())
+	assert.NoError(t, f.Close())
+	assert.Equal(t, Status{Submitted: 1}, f.Status())
+
+	info, err := os.Stat(filepath.Join(dir, "payloads.bin"))
+	assert.NoError(t, err)
+	assert.NotZero(t, info.Size())
+}
@@ -0,0 +1,113 @@
+package forwarder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// HTTPForwarder posts the payloads to the Datadog intake. The CollectorStatus
+// returned by the intake is kept in its Status.
+type HTTPForwarder struct {
+	statusTracker
+	endpoint url.URL
+	apiKey   string
+	hostName string
+	version  string
+	client   http.Client
+}
+
+// NewHTTPForwarder returns an HTTPForwarder submitting to the intake of cfg.
+func NewHTTPForwarder(cfg *config.AgentConfig, version string) *HTTPForwarder {
+	return &HTTPForwarder{
+		endpoint: *cfg.APIEndpoint,
+		apiKey:   cfg.APIKey,
+		hostName: cfg.HostName,
+		version:  version,
+		client:   http.Client{Transport: cfg.Transport},
+	}
+}
+
+// Name returns the name of the HTTPForwarder.
+func (f *HTTPForwarder) Name() string { return "intake " + f.endpoint.Host }
+
+// Submit posts the message to the endpoint of the intake and decodes its response.
+func (f *HTTPForwarder) Submit(endpoint string, m model.Message) error {
+	return f.record(f.post(endpoint, m))
+}
+
+// Flush is a no-op as messages are submitted synchronously.
+func (f *HTTPForwarder) Flush() error { return nil }
+
+// Close is a no-op as the HTTPForwarder doesn't hold any resource.
+func (f *HTTPForwarder) Close() error { return nil }
+
+func (f *HTTPForwarder) post(endpoint string, m model.Message) error {
+	body, err := model.EncodeMessage(m)
+	if err != nil {
+		return fmt.Errorf("unable to encode message: %s", err)
+	}
+	u := f.endpoint
+	u.Path = endpoint
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request: %s", err)
+	}
+	req.Header.Add("X-Dd-APIKey", f.apiKey)
+	req.Header.Add("X-Dd-Hostname", f.hostName)
+	req.Header.Add("X-Dd-Processagentversion", f.version)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if isHTTPTimeout(err) {
+			return fmt.Errorf("timeout detected, %s", err)
+		}
+		return fmt.Errorf("error submitting payload: %s", err)
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 300 {
+		io.Copy(ioutil.Discard, resp.Body)
+		return fmt.Errorf("unexpected response from %s. Status: %s", u.String(), resp.Status)
+	}
+
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not decode response body: %s", err)
+	}
+
+	r, err := model.DecodeMessage(body)
+	if err != nil {
+		return fmt.Errorf("could not decode response, invalid format: %s", err)
+	}
+	switch r.Header.Type {
+	case model.TypeResCollector:
+		rm := r.Body.(*model.ResCollector)
+		if len(rm.Message) > 0 {
+			return fmt.Errorf("%s", rm.Message)
+		}
+		f.setCollectorStatus(rm.Status)
+		return nil
+	default:
+		return fmt.Errorf("unexpected response type: %d", r.Header.Type)
+	}
+}
+
+// isHTTPTimeout returns true if the error is due to reaching the timeout limit on the http.client
+func isHTTPTimeout(err error) bool {
+	if netErr, ok := err.(interface {
+		Timeout() bool
+	}); ok && netErr.Timeout() {
+		return true
+	} else if strings.Contains(err.Error(), "use of closed network connection") { //To deprecate when using GO > 1.5
+		return true
+	}
+	return false
+}
@@ -13,17 +13,23 @@
 		CollectorProc
 		CollectorConnections
 		CollectorRealTime
+		OmittedProcesses
 		CollectorContainer
 		CollectorContainerRealTime
+		CollectorSystemStats
 		CollectorReqStatus
 		CollectorStatus
+		RemoteSettings
 		Process
 		Command
 		ProcessUser
 		Container
+		ContainerPort
+		ContainerProcess
 		ProcessStat
 		ContainerStat
 		SystemInfo
+		SystemStats
 		OSInfo
 		IOStat
 		Connection
@@ -209,6 +215,11 @@ type CollectorProc struct {
 	Kubernetes *datadog_agentpayload.KubeMetadataPayload `protobuf:"bytes,8,opt,name=kubernetes" json:"kubernetes,omitempty"`
 	Ecs        *datadog_agentpayload.ECSMetadataPayload  `protobuf:"bytes,9,opt,name=ecs" json:"ecs,omitempty"`
 	Containers []*Container                              `protobuf:"bytes,10,rep,name=containers" json:"containers,omitempty"`
+	// The processes left out by the sampling, only set on the first message of the group.
+	Omitted *OmittedProcesses `protobuf:"bytes,11,opt,name=omitted" json:"omitted,omitempty"`
+	// Set when the processes already sent since the last full payload only carry their
+	// command and user when they changed, the process being keyed by pid and createTime.
+	Delta bool `protobuf:"varint,12,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
 func (m *CollectorProc) Reset()                    { *m = CollectorProc{} }
@@ -258,6 +269,13 @@ func (m *CollectorProc) GetContainers() []*Container {
 	return nil
 }
 
+func (m *CollectorProc) GetOmitted() *OmittedProcesses {
+	if m != nil {
+		return m.Omitted
+	}
+	return nil
+}
+
 type CollectorConnections struct {
 	HostName    string        `protobuf:"bytes,2,opt,name=hostName,proto3" json:"hostName,omitempty"`
 	Connections []*Connection `protobuf:"bytes,3,rep,name=connections" json:"connections,omitempty"`
@@ -294,6 +312,8 @@ type CollectorRealTime struct {
 	NumCpus        int32            `protobuf:"varint,8,opt,name=numCpus,proto3" json:"numCpus,omitempty"`
 	TotalMemory    int64            `protobuf:"varint,9,opt,name=totalMemory,proto3" json:"totalMemory,omitempty"`
 	ContainerStats []*ContainerStat `protobuf:"bytes,10,rep,name=containerStats" json:"containerStats,omitempty"`
+	// The processes left out by the sampling, only set on the first message of the group.
+	Omitted *OmittedProcesses `protobuf:"bytes,11,opt,name=omitted" json:"omitted,omitempty"`
 }
 
 func (m *CollectorRealTime) Reset()                    { *m = CollectorRealTime{} }
@@ -315,6 +335,29 @@ func (m *CollectorRealTime) GetContainerStats() []*ContainerStat {
 	return nil
 }
 
+func (m *CollectorRealTime) GetOmitted() *OmittedProcesses {
+	if m != nil {
+		return m.Omitted
+	}
+	return nil
+}
+
+// OmittedProcesses summarizes the processes left out of a collection by the
+// sampling, which only keeps the top processes and a rotating sample of the rest.
+type OmittedProcesses struct {
+	Count               int32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	TotalCpuPct         float32 `protobuf:"fixed32,2,opt,name=totalCpuPct,proto3" json:"totalCpuPct,omitempty"`
+	TotalRss            uint64  `protobuf:"varint,3,opt,name=totalRss,proto3" json:"totalRss,omitempty"`
+	TotalReadBytesRate  float32 `protobuf:"fixed32,4,opt,name=totalReadBytesRate,proto3" json:"totalReadBytesRate,omitempty"`
+	TotalWriteBytesRate float32 `protobuf:"fixed32,5,opt,name=totalWriteBytesRate,proto3" json:"totalWriteBytesRate,omitempty"`
+	TotalOpenFdCount    int64   `protobuf:"varint,6,opt,name=totalOpenFdCount,proto3" json:"totalOpenFdCount,omitempty"`
+}
+
+func (m *OmittedProcesses) Reset()                    { *m = OmittedProcesses{} }
+func (m *OmittedProcesses) String() string            { return proto.CompactTextString(m) }
+func (*OmittedProcesses) ProtoMessage()               {}
+func (*OmittedProcesses) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
+
 type CollectorContainer struct {
 	HostName   string       `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
 	Info       *SystemInfo  `protobuf:"bytes,2,opt,name=info" json:"info,omitempty"`
@@ -331,7 +374,7 @@ type CollectorContainer struct {
 func (m *CollectorContainer) Reset()                    { *m = CollectorContainer{} }
 func (m *CollectorContainer) String() string            { return proto.CompactTextString(m) }
 func (*CollectorContainer) ProtoMessage()               {}
-func (*CollectorContainer) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
+func (*CollectorContainer) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
 
 func (m *CollectorContainer) GetInfo() *SystemInfo {
 	if m != nil {
@@ -383,7 +426,7 @@ type CollectorContainerRealTime struct {
 func (m *CollectorContainerRealTime) Reset()                    { *m = CollectorContainerRealTime{} }
 func (m *CollectorContainerRealTime) String() string            { return proto.CompactTextString(m) }
 func (*CollectorContainerRealTime) ProtoMessage()               {}
-func (*CollectorContainerRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
+func (*CollectorContainerRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
 
 func (m *CollectorContainerRealTime) GetStats() []*ContainerStat {
 	if m != nil {
@@ -392,6 +435,34 @@ func (m *CollectorContainerRealTime) GetStats() []*ContainerStat {
 	return nil
 }
 
+type CollectorSystemStats struct {
+	HostName  string       `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
+	Stats     *SystemStats `protobuf:"bytes,2,opt,name=stats" json:"stats,omitempty"`
+	GroupId   int32        `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupSize int32        `protobuf:"varint,4,opt,name=groupSize,proto3" json:"groupSize,omitempty"`
+	// Post-resolved fields
+	Host *Host `protobuf:"bytes,5,opt,name=host" json:"host,omitempty"`
+}
+
+func (m *CollectorSystemStats) Reset()                    { *m = CollectorSystemStats{} }
+func (m *CollectorSystemStats) String() string            { return proto.CompactTextString(m) }
+func (*CollectorSystemStats) ProtoMessage()               {}
+func (*CollectorSystemStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+
+func (m *CollectorSystemStats) GetStats() *SystemStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *CollectorSystemStats) GetHost() *Host {
+	if m != nil {
+		return m.Host
+	}
+	return nil
+}
+
 type CollectorReqStatus struct {
 	HostName string `protobuf:"bytes,2,opt,name=hostName,proto3" json:"hostName,omitempty"`
 }
@@ -399,17 +470,62 @@ type CollectorReqStatus struct {
 func (m *CollectorReqStatus) Reset()                    { *m = CollectorReqStatus{} }
 func (m *CollectorReqStatus) String() string            { return proto.CompactTextString(m) }
 func (*CollectorReqStatus) ProtoMessage()               {}
-func (*CollectorReqStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
+func (*CollectorReqStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
 
 type CollectorStatus struct {
 	ActiveClients int32 `protobuf:"varint,1,opt,name=activeClients,proto3" json:"activeClients,omitempty"`
 	Interval      int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Overrides of the local configuration, missing when the intake leaves them unchanged.
+	Settings *RemoteSettings `protobuf:"bytes,3,opt,name=settings" json:"settings,omitempty"`
+	// Requests the next CollectorProc payload to carry the full metadata of every process.
+	Resync bool `protobuf:"varint,4,opt,name=resync,proto3" json:"resync,omitempty"`
 }
 
 func (m *CollectorStatus) Reset()                    { *m = CollectorStatus{} }
 func (m *CollectorStatus) String() string            { return proto.CompactTextString(m) }
 func (*CollectorStatus) ProtoMessage()               {}
-func (*CollectorStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+func (*CollectorStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
+
+func (m *CollectorStatus) GetSettings() *RemoteSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+// RemoteSettings are the complete set of overrides of the local configuration
+// pushed by the intake, an empty message reverting to the local configuration.
+// They are kept in memory only.
+type RemoteSettings struct {
+	// The interval in seconds of the non real-time checks, by check name.
+	CheckIntervals map[string]int32 `protobuf:"bytes,1,rep,name=checkIntervals" json:"checkIntervals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Disables checks, or keeps them enabled, by check name. Only the checks
+	// enabled in the local configuration can be enabled.
+	Checks map[string]bool `protobuf:"bytes,2,rep,name=checks" json:"checks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// The maximum number of processes or containers per message, when positive.
+	ProcLimit int32 `protobuf:"varint,3,opt,name=procLimit,proto3" json:"procLimit,omitempty"`
+	// Regex patterns excluding processes, added to the configured blacklist.
+	BlacklistPatterns []string `protobuf:"bytes,4,rep,name=blacklistPatterns" json:"blacklistPatterns,omitempty"`
+}
+
+func (m *RemoteSettings) Reset()                    { *m = RemoteSettings{} }
+func (m *RemoteSettings) String() string            { return proto.CompactTextString(m) }
+func (*RemoteSettings) ProtoMessage()               {}
+func (*RemoteSettings) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
+
+func (m *RemoteSettings) GetCheckIntervals() map[string]int32 {
+	if m != nil {
+		return m.CheckIntervals
+	}
+	return nil
+}
+
+func (m *RemoteSettings) GetChecks() map[string]bool {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
 
 type Process struct {
 	Key     uint32       `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -436,7 +552,7 @@ type Process struct {
 func (m *Process) Reset()                    { *m = Process{} }
 func (m *Process) String() string            { return proto.CompactTextString(m) }
 func (*Process) ProtoMessage()               {}
-func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
 
 func (m *Process) GetHost() *Host {
 	if m != nil {
@@ -500,7 +616,7 @@ type Command struct {
 func (m *Command) Reset()                    { *m = Command{} }
 func (m *Command) String() string            { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()               {}
-func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
+func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
 
 type ProcessUser struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -515,7 +631,7 @@ type ProcessUser struct {
 func (m *ProcessUser) Reset()                    { *m = ProcessUser{} }
 func (m *ProcessUser) String() string            { return proto.CompactTextString(m) }
 func (*ProcessUser) ProtoMessage()               {}
-func (*ProcessUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
+func (*ProcessUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
 
 type Container struct {
 	Type        string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -525,31 +641,44 @@ type Container struct {
 	CpuLimit    float32 `protobuf:"fixed32,5,opt,name=cpuLimit,proto3" json:"cpuLimit,omitempty"`
 	MemoryLimit uint64  `protobuf:"varint,6,opt,name=memoryLimit,proto3" json:"memoryLimit,omitempty"`
 	// 7 is removed, do not use.
-	State      ContainerState  `protobuf:"varint,8,opt,name=state,proto3,enum=datadog.process_agent.ContainerState" json:"state,omitempty"`
-	Health     ContainerHealth `protobuf:"varint,9,opt,name=health,proto3,enum=datadog.process_agent.ContainerHealth" json:"health,omitempty"`
-	Created    int64           `protobuf:"varint,10,opt,name=created,proto3" json:"created,omitempty"`
-	Rbps       float32         `protobuf:"fixed32,11,opt,name=rbps,proto3" json:"rbps,omitempty"`
-	Wbps       float32         `protobuf:"fixed32,12,opt,name=wbps,proto3" json:"wbps,omitempty"`
-	Key        uint32          `protobuf:"varint,13,opt,name=key,proto3" json:"key,omitempty"`
-	NetRcvdPs  float32         `protobuf:"fixed32,14,opt,name=netRcvdPs,proto3" json:"netRcvdPs,omitempty"`
-	NetSentPs  float32         `protobuf:"fixed32,15,opt,name=netSentPs,proto3" json:"netSentPs,omitempty"`
-	NetRcvdBps float32         `protobuf:"fixed32,16,opt,name=netRcvdBps,proto3" json:"netRcvdBps,omitempty"`
-	NetSentBps float32         `protobuf:"fixed32,17,opt,name=netSentBps,proto3" json:"netSentBps,omitempty"`
-	UserPct    float32         `protobuf:"fixed32,18,opt,name=userPct,proto3" json:"userPct,omitempty"`
-	SystemPct  float32         `protobuf:"fixed32,19,opt,name=systemPct,proto3" json:"systemPct,omitempty"`
-	TotalPct   float32         `protobuf:"fixed32,20,opt,name=totalPct,proto3" json:"totalPct,omitempty"`
-	MemRss     uint64          `protobuf:"varint,21,opt,name=memRss,proto3" json:"memRss,omitempty"`
-	MemCache   uint64          `protobuf:"varint,22,opt,name=memCache,proto3" json:"memCache,omitempty"`
-	Host       *Host           `protobuf:"bytes,23,opt,name=host" json:"host,omitempty"`
-	Started    int64           `protobuf:"varint,24,opt,name=started,proto3" json:"started,omitempty"`
-	ByteKey    []byte          `protobuf:"bytes,25,opt,name=byteKey,proto3" json:"byteKey,omitempty"`
-	Tags       []string        `protobuf:"bytes,26,rep,name=tags" json:"tags,omitempty"`
+	State       ContainerState    `protobuf:"varint,8,opt,name=state,proto3,enum=datadog.process_agent.ContainerState" json:"state,omitempty"`
+	Health      ContainerHealth   `protobuf:"varint,9,opt,name=health,proto3,enum=datadog.process_agent.ContainerHealth" json:"health,omitempty"`
+	Created     int64             `protobuf:"varint,10,opt,name=created,proto3" json:"created,omitempty"`
+	Rbps        float32           `protobuf:"fixed32,11,opt,name=rbps,proto3" json:"rbps,omitempty"`
+	Wbps        float32           `protobuf:"fixed32,12,opt,name=wbps,proto3" json:"wbps,omitempty"`
+	Key         uint32            `protobuf:"varint,13,opt,name=key,proto3" json:"key,omitempty"`
+	NetRcvdPs   float32           `protobuf:"fixed32,14,opt,name=netRcvdPs,proto3" json:"netRcvdPs,omitempty"`
+	NetSentPs   float32           `protobuf:"fixed32,15,opt,name=netSentPs,proto3" json:"netSentPs,omitempty"`
+	NetRcvdBps  float32           `protobuf:"fixed32,16,opt,name=netRcvdBps,proto3" json:"netRcvdBps,omitempty"`
+	NetSentBps  float32           `protobuf:"fixed32,17,opt,name=netSentBps,proto3" json:"netSentBps,omitempty"`
+	UserPct     float32           `protobuf:"fixed32,18,opt,name=userPct,proto3" json:"userPct,omitempty"`
+	SystemPct   float32           `protobuf:"fixed32,19,opt,name=systemPct,proto3" json:"systemPct,omitempty"`
+	TotalPct    float32           `protobuf:"fixed32,20,opt,name=totalPct,proto3" json:"totalPct,omitempty"`
+	MemRss      uint64            `protobuf:"varint,21,opt,name=memRss,proto3" json:"memRss,omitempty"`
+	MemCache    uint64            `protobuf:"varint,22,opt,name=memCache,proto3" json:"memCache,omitempty"`
+	Host        *Host             `protobuf:"bytes,23,opt,name=host" json:"host,omitempty"`
+	Started     int64             `protobuf:"varint,24,opt,name=started,proto3" json:"started,omitempty"`
+	ByteKey     []byte            `protobuf:"bytes,25,opt,name=byteKey,proto3" json:"byteKey,omitempty"`
+	Tags        []string          `protobuf:"bytes,26,rep,name=tags" json:"tags,omitempty"`
+	ImageName   string            `protobuf:"bytes,27,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	ImageTag    string            `protobuf:"bytes,28,opt,name=imageTag,proto3" json:"imageTag,omitempty"`
+	ImageDigest string            `protobuf:"bytes,29,opt,name=imageDigest,proto3" json:"imageDigest,omitempty"`
+	Command     []string          `protobuf:"bytes,30,rep,name=command" json:"command,omitempty"`
+	Entrypoint  []string          `protobuf:"bytes,31,rep,name=entrypoint" json:"entrypoint,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,32,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ports       []*ContainerPort  `protobuf:"bytes,33,rep,name=ports" json:"ports,omitempty"`
+	// Rollups of the processes running in the container
+	ProcessCount    int32               `protobuf:"varint,34,opt,name=processCount,proto3" json:"processCount,omitempty"`
+	ThreadCount     int32               `protobuf:"varint,35,opt,name=threadCount,proto3" json:"threadCount,omitempty"`
+	OpenFdCount     int32               `protobuf:"varint,36,opt,name=openFdCount,proto3" json:"openFdCount,omitempty"`
+	TopCpuProcesses []*ContainerProcess `protobuf:"bytes,37,rep,name=topCpuProcesses" json:"topCpuProcesses,omitempty"`
+	TopMemProcesses []*ContainerProcess `protobuf:"bytes,38,rep,name=topMemProcesses" json:"topMemProcesses,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
 
 func (m *Container) GetHost() *Host {
 	if m != nil {
@@ -558,6 +687,58 @@ func (m *Container) GetHost() *Host {
 	return nil
 }
 
+func (m *Container) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Container) GetPorts() []*ContainerPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *Container) GetTopCpuProcesses() []*ContainerProcess {
+	if m != nil {
+		return m.TopCpuProcesses
+	}
+	return nil
+}
+
+func (m *Container) GetTopMemProcesses() []*ContainerProcess {
+	if m != nil {
+		return m.TopMemProcesses
+	}
+	return nil
+}
+
+type ContainerPort struct {
+	Port     int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (m *ContainerPort) Reset()                    { *m = ContainerPort{} }
+func (m *ContainerPort) String() string            { return proto.CompactTextString(m) }
+func (*ContainerPort) ProtoMessage()               {}
+func (*ContainerPort) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
+
+// ContainerProcess is a summary of a process running in a container.
+type ContainerProcess struct {
+	Pid        int32   `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	CreateTime int64   `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CpuPct     float32 `protobuf:"fixed32,4,opt,name=cpuPct,proto3" json:"cpuPct,omitempty"`
+	MemRss     uint64  `protobuf:"varint,5,opt,name=memRss,proto3" json:"memRss,omitempty"`
+}
+
+func (m *ContainerProcess) Reset()                    { *m = ContainerProcess{} }
+func (m *ContainerProcess) String() string            { return proto.CompactTextString(m) }
+func (*ContainerProcess) ProtoMessage()               {}
+func (*ContainerProcess) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
+
 // ProcessStat is used for real-time process messages. It should only contain
 // data that can change for a running process (and relevant information to
 // generate a key). We will send a lot of these in the real-time messages so
@@ -598,7 +779,7 @@ type ProcessStat struct {
 func (m *ProcessStat) Reset()                    { *m = ProcessStat{} }
 func (m *ProcessStat) String() string            { return proto.CompactTextString(m) }
 func (*ProcessStat) ProtoMessage()               {}
-func (*ProcessStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
+func (*ProcessStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{17} }
 
 func (m *ProcessStat) GetMemory() *MemoryStat {
 	if m != nil {
@@ -651,7 +832,7 @@ type ContainerStat struct {
 func (m *ContainerStat) Reset()                    { *m = ContainerStat{} }
 func (m *ContainerStat) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStat) ProtoMessage()               {}
-func (*ContainerStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
+func (*ContainerStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
 
 type SystemInfo struct {
 	Uuid string     `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -659,12 +840,14 @@ type SystemInfo struct {
 	Cpus []*CPUInfo `protobuf:"bytes,3,rep,name=cpus" json:"cpus,omitempty"`
 	// 4 is deprecated
 	TotalMemory int64 `protobuf:"varint,5,opt,name=totalMemory,proto3" json:"totalMemory,omitempty"`
+	// Incremented each time the agent detects a change of the fields above
+	Sequence int64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *SystemInfo) Reset()                    { *m = SystemInfo{} }
 func (m *SystemInfo) String() string            { return proto.CompactTextString(m) }
 func (*SystemInfo) ProtoMessage()               {}
-func (*SystemInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
+func (*SystemInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
 
 func (m *SystemInfo) GetOs() *OSInfo {
 	if m != nil {
@@ -680,6 +863,53 @@ func (m *SystemInfo) GetCpus() []*CPUInfo {
 	return nil
 }
 
+// SystemStats are the host-level statistics collected alongside processes. Rates
+// and CPU percentages are computed over the time since the previous collection.
+type SystemStats struct {
+	Load1         float32 `protobuf:"fixed32,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5         float32 `protobuf:"fixed32,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15        float32 `protobuf:"fixed32,3,opt,name=load15,proto3" json:"load15,omitempty"`
+	UserPct       float32 `protobuf:"fixed32,4,opt,name=userPct,proto3" json:"userPct,omitempty"`
+	SystemPct     float32 `protobuf:"fixed32,5,opt,name=systemPct,proto3" json:"systemPct,omitempty"`
+	IdlePct       float32 `protobuf:"fixed32,6,opt,name=idlePct,proto3" json:"idlePct,omitempty"`
+	NicePct       float32 `protobuf:"fixed32,7,opt,name=nicePct,proto3" json:"nicePct,omitempty"`
+	IowaitPct     float32 `protobuf:"fixed32,8,opt,name=iowaitPct,proto3" json:"iowaitPct,omitempty"`
+	IrqPct        float32 `protobuf:"fixed32,9,opt,name=irqPct,proto3" json:"irqPct,omitempty"`
+	SoftirqPct    float32 `protobuf:"fixed32,10,opt,name=softirqPct,proto3" json:"softirqPct,omitempty"`
+	StealPct      float32 `protobuf:"fixed32,11,opt,name=stealPct,proto3" json:"stealPct,omitempty"`
+	GuestPct      float32 `protobuf:"fixed32,12,opt,name=guestPct,proto3" json:"guestPct,omitempty"`
+	MemTotal      uint64  `protobuf:"varint,13,opt,name=memTotal,proto3" json:"memTotal,omitempty"`
+	MemAvailable  uint64  `protobuf:"varint,14,opt,name=memAvailable,proto3" json:"memAvailable,omitempty"`
+	MemUsed       uint64  `protobuf:"varint,15,opt,name=memUsed,proto3" json:"memUsed,omitempty"`
+	MemFree       uint64  `protobuf:"varint,16,opt,name=memFree,proto3" json:"memFree,omitempty"`
+	MemBuffers    uint64  `protobuf:"varint,17,opt,name=memBuffers,proto3" json:"memBuffers,omitempty"`
+	MemCached     uint64  `protobuf:"varint,18,opt,name=memCached,proto3" json:"memCached,omitempty"`
+	MemShared     uint64  `protobuf:"varint,19,opt,name=memShared,proto3" json:"memShared,omitempty"`
+	MemSlab       uint64  `protobuf:"varint,20,opt,name=memSlab,proto3" json:"memSlab,omitempty"`
+	SwapTotal     uint64  `protobuf:"varint,21,opt,name=swapTotal,proto3" json:"swapTotal,omitempty"`
+	SwapUsed      uint64  `protobuf:"varint,22,opt,name=swapUsed,proto3" json:"swapUsed,omitempty"`
+	SwapFree      uint64  `protobuf:"varint,23,opt,name=swapFree,proto3" json:"swapFree,omitempty"`
+	SwapInBps     float32 `protobuf:"fixed32,24,opt,name=swapInBps,proto3" json:"swapInBps,omitempty"`
+	SwapOutBps    float32 `protobuf:"fixed32,25,opt,name=swapOutBps,proto3" json:"swapOutBps,omitempty"`
+	CtxSwitchesPs float32 `protobuf:"fixed32,26,opt,name=ctxSwitchesPs,proto3" json:"ctxSwitchesPs,omitempty"`
+	ForksPs       float32 `protobuf:"fixed32,27,opt,name=forksPs,proto3" json:"forksPs,omitempty"`
+	ProcsTotal    int32   `protobuf:"varint,28,opt,name=procsTotal,proto3" json:"procsTotal,omitempty"`
+	ProcsRunning  int32   `protobuf:"varint,29,opt,name=procsRunning,proto3" json:"procsRunning,omitempty"`
+	ProcsSleeping int32   `protobuf:"varint,30,opt,name=procsSleeping,proto3" json:"procsSleeping,omitempty"`
+	ProcsBlocked  int32   `protobuf:"varint,31,opt,name=procsBlocked,proto3" json:"procsBlocked,omitempty"`
+	ProcsStopped  int32   `protobuf:"varint,32,opt,name=procsStopped,proto3" json:"procsStopped,omitempty"`
+	ProcsZombie   int32   `protobuf:"varint,33,opt,name=procsZombie,proto3" json:"procsZombie,omitempty"`
+	ProcsIdle     int32   `protobuf:"varint,34,opt,name=procsIdle,proto3" json:"procsIdle,omitempty"`
+	ThreadsTotal  int32   `protobuf:"varint,35,opt,name=threadsTotal,proto3" json:"threadsTotal,omitempty"`
+	OpenFds       uint64  `protobuf:"varint,36,opt,name=openFds,proto3" json:"openFds,omitempty"`
+	MaxFds        uint64  `protobuf:"varint,37,opt,name=maxFds,proto3" json:"maxFds,omitempty"`
+}
+
+func (m *SystemStats) Reset()                    { *m = SystemStats{} }
+func (m *SystemStats) String() string            { return proto.CompactTextString(m) }
+func (*SystemStats) ProtoMessage()               {}
+func (*SystemStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{20} }
+
 type OSInfo struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Platform      string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
@@ -691,7 +921,7 @@ type OSInfo struct {
 func (m *OSInfo) Reset()                    { *m = OSInfo{} }
 func (m *OSInfo) String() string            { return proto.CompactTextString(m) }
 func (*OSInfo) ProtoMessage()               {}
-func (*OSInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
+func (*OSInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{21} }
 
 type IOStat struct {
 	ReadRate       float32 `protobuf:"fixed32,1,opt,name=readRate,proto3" json:"readRate,omitempty"`
@@ -703,7 +933,7 @@ type IOStat struct {
 func (m *IOStat) Reset()                    { *m = IOStat{} }
 func (m *IOStat) String() string            { return proto.CompactTextString(m) }
 func (*IOStat) ProtoMessage()               {}
-func (*IOStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
+func (*IOStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{22} }
 
 type Connection struct {
 	Pid    int32  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
@@ -718,7 +948,7 @@ type Connection struct {
 func (m *Connection) Reset()                    { *m = Connection{} }
 func (m *Connection) String() string            { return proto.CompactTextString(m) }
 func (*Connection) ProtoMessage()               {}
-func (*Connection) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{17} }
+func (*Connection) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{23} }
 
 func (m *Connection) GetLaddr() *Addr {
 	if m != nil {
@@ -743,7 +973,7 @@ type Addr struct {
 func (m *Addr) Reset()                    { *m = Addr{} }
 func (m *Addr) String() string            { return proto.CompactTextString(m) }
 func (*Addr) ProtoMessage()               {}
-func (*Addr) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
+func (*Addr) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
 
 func (m *Addr) GetHost() *Host {
 	if m != nil {
@@ -766,7 +996,7 @@ type MemoryStat struct {
 func (m *MemoryStat) Reset()                    { *m = MemoryStat{} }
 func (m *MemoryStat) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStat) ProtoMessage()               {}
-func (*MemoryStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
+func (*MemoryStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{25} }
 
 type CPUStat struct {
 	LastCpu    string           `protobuf:"bytes,1,opt,name=lastCpu,proto3" json:"lastCpu,omitempty"`
@@ -783,7 +1013,7 @@ type CPUStat struct {
 func (m *CPUStat) Reset()                    { *m = CPUStat{} }
 func (m *CPUStat) String() string            { return proto.CompactTextString(m) }
 func (*CPUStat) ProtoMessage()               {}
-func (*CPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{20} }
+func (*CPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{26} }
 
 func (m *CPUStat) GetCpus() []*SingleCPUStat {
 	if m != nil {
@@ -800,7 +1030,7 @@ type SingleCPUStat struct {
 func (m *SingleCPUStat) Reset()                    { *m = SingleCPUStat{} }
 func (m *SingleCPUStat) String() string            { return proto.CompactTextString(m) }
 func (*SingleCPUStat) ProtoMessage()               {}
-func (*SingleCPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{21} }
+func (*SingleCPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{27} }
 
 type CPUInfo struct {
 	Number     int32  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
@@ -817,7 +1047,7 @@ type CPUInfo struct {
 func (m *CPUInfo) Reset()                    { *m = CPUInfo{} }
 func (m *CPUInfo) String() string            { return proto.CompactTextString(m) }
 func (*CPUInfo) ProtoMessage()               {}
-func (*CPUInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{22} }
+func (*CPUInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{28} }
 
 // Host and HostTags are used in backend post-resolution
 type Host struct {
@@ -833,7 +1063,7 @@ type Host struct {
 func (m *Host) Reset()                    { *m = Host{} }
 func (m *Host) String() string            { return proto.CompactTextString(m) }
 func (*Host) ProtoMessage()               {}
-func (*Host) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{23} }
+func (*Host) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{29} }
 
 func (m *Host) GetTags() []*HostTags {
 	if m != nil {
@@ -850,7 +1080,7 @@ type HostTags struct {
 func (m *HostTags) Reset()                    { *m = HostTags{} }
 func (m *HostTags) String() string            { return proto.CompactTextString(m) }
 func (*HostTags) ProtoMessage()               {}
-func (*HostTags) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
+func (*HostTags) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{30} }
 
 func init() {
 	proto.RegisterType((*ResCollector)(nil), "datadog.process_agent.ResCollector")
@@ -858,17 +1088,23 @@ func init() {
 	proto.RegisterType((*CollectorProc)(nil), "datadog.process_agent.CollectorProc")
 	proto.RegisterType((*CollectorConnections)(nil), "datadog.process_agent.CollectorConnections")
 	proto.RegisterType((*CollectorRealTime)(nil), "datadog.process_agent.CollectorRealTime")
+	proto.RegisterType((*OmittedProcesses)(nil), "datadog.process_agent.OmittedProcesses")
 	proto.RegisterType((*CollectorContainer)(nil), "datadog.process_agent.CollectorContainer")
 	proto.RegisterType((*CollectorContainerRealTime)(nil), "datadog.process_agent.CollectorContainerRealTime")
+	proto.RegisterType((*CollectorSystemStats)(nil), "datadog.process_agent.CollectorSystemStats")
 	proto.RegisterType((*CollectorReqStatus)(nil), "datadog.process_agent.CollectorReqStatus")
 	proto.RegisterType((*CollectorStatus)(nil), "datadog.process_agent.CollectorStatus")
+	proto.RegisterType((*RemoteSettings)(nil), "datadog.process_agent.RemoteSettings")
 	proto.RegisterType((*Process)(nil), "datadog.process_agent.Process")
 	proto.RegisterType((*Command)(nil), "datadog.process_agent.Command")
 	proto.RegisterType((*ProcessUser)(nil), "datadog.process_agent.ProcessUser")
 	proto.RegisterType((*Container)(nil), "datadog.process_agent.Container")
+	proto.RegisterType((*ContainerPort)(nil), "datadog.process_agent.ContainerPort")
+	proto.RegisterType((*ContainerProcess)(nil), "datadog.process_agent.ContainerProcess")
 	proto.RegisterType((*ProcessStat)(nil), "datadog.process_agent.ProcessStat")
 	proto.RegisterType((*ContainerStat)(nil), "datadog.process_agent.ContainerStat")
 	proto.RegisterType((*SystemInfo)(nil), "datadog.process_agent.SystemInfo")
+	proto.RegisterType((*SystemStats)(nil), "datadog.process_agent.SystemStats")
 	proto.RegisterType((*OSInfo)(nil), "datadog.process_agent.OSInfo")
 	proto.RegisterType((*IOStat)(nil), "datadog.process_agent.IOStat")
 	proto.RegisterType((*Connection)(nil), "datadog.process_agent.Connection")
@@ -1045,6 +1281,26 @@ func (m *CollectorProc) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Omitted != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Omitted.Size()))
+		n7, err := m.Omitted.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Delta {
+		data[i] = 0x60
+		i++
+		if m.Delta {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1085,11 +1341,11 @@ func (m *CollectorConnections) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x22
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n7, err := m.Host.MarshalTo(data[i:])
+		n8, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	return i, nil
 }
@@ -1169,6 +1425,64 @@ func (m *CollectorRealTime) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Omitted != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Omitted.Size()))
+		n9, err := m.Omitted.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *OmittedProcesses) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *OmittedProcesses) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Count))
+	}
+	if m.TotalCpuPct != 0 {
+		data[i] = 0x15
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalCpuPct))))
+	}
+	if m.TotalRss != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.TotalRss))
+	}
+	if m.TotalReadBytesRate != 0 {
+		data[i] = 0x25
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalReadBytesRate))))
+	}
+	if m.TotalWriteBytesRate != 0 {
+		data[i] = 0x2d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalWriteBytesRate))))
+	}
+	if m.TotalOpenFdCount != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.TotalOpenFdCount))
+	}
 	return i, nil
 }
 
@@ -1197,11 +1511,11 @@ func (m *CollectorContainer) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Info.Size()))
-		n8, err := m.Info.MarshalTo(data[i:])
+		n10, err := m.Info.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if len(m.Containers) > 0 {
 		for _, msg := range m.Containers {
@@ -1229,31 +1543,31 @@ func (m *CollectorContainer) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x32
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Kubernetes.Size()))
-		n9, err := m.Kubernetes.MarshalTo(data[i:])
+		n11, err := m.Kubernetes.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.Ecs != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Ecs.Size()))
-		n10, err := m.Ecs.MarshalTo(data[i:])
+		n12, err := m.Ecs.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.Host != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n11, err := m.Host.MarshalTo(data[i:])
+		n13, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	return i, nil
 }
@@ -1319,6 +1633,60 @@ func (m *CollectorContainerRealTime) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CollectorSystemStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CollectorSystemStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostName) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.HostName)))
+		i += copy(data[i:], m.HostName)
+	}
+	if m.Stats != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Stats.Size()))
+		n14, err := m.Stats.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.GroupId != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupSize))
+	}
+	if m.Host != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
+		n15, err := m.Host.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
 func (m *CollectorReqStatus) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1368,6 +1736,101 @@ func (m *CollectorStatus) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Interval))
 	}
+	if m.Settings != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Settings.Size()))
+		n16, err := m.Settings.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Resync {
+		data[i] = 0x20
+		i++
+		if m.Resync {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *RemoteSettings) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *RemoteSettings) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckIntervals) > 0 {
+		for k, _ := range m.CheckIntervals {
+			data[i] = 0xa
+			i++
+			v := m.CheckIntervals[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			i = encodeVarintAgent(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintAgent(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			i = encodeVarintAgent(data, i, uint64(v))
+		}
+	}
+	if len(m.Checks) > 0 {
+		for k, _ := range m.Checks {
+			data[i] = 0x12
+			i++
+			v := m.Checks[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + 1
+			i = encodeVarintAgent(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintAgent(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x10
+			i++
+			if v {
+				data[i] = 1
+			} else {
+				data[i] = 0
+			}
+			i++
+		}
+	}
+	if m.ProcLimit != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcLimit))
+	}
+	if len(m.BlacklistPatterns) > 0 {
+		for _, s := range m.BlacklistPatterns {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1400,51 +1863,51 @@ func (m *Process) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n12, err := m.Host.MarshalTo(data[i:])
+		n17, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n17
 	}
 	if m.Command != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Command.Size()))
-		n13, err := m.Command.MarshalTo(data[i:])
+		n18, err := m.Command.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n18
 	}
 	if m.User != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.User.Size()))
-		n14, err := m.User.MarshalTo(data[i:])
+		n19, err := m.User.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n19
 	}
 	if m.Memory != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Memory.Size()))
-		n15, err := m.Memory.MarshalTo(data[i:])
+		n20, err := m.Memory.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n20
 	}
 	if m.Cpu != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Cpu.Size()))
-		n16, err := m.Cpu.MarshalTo(data[i:])
+		n21, err := m.Cpu.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n21
 	}
 	if m.CreateTime != 0 {
 		data[i] = 0x48
@@ -1455,11 +1918,11 @@ func (m *Process) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x52
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Container.Size()))
-		n17, err := m.Container.MarshalTo(data[i:])
+		n22, err := m.Container.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n22
 	}
 	if m.OpenFdCount != 0 {
 		data[i] = 0x58
@@ -1475,11 +1938,11 @@ func (m *Process) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x6a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.IoStat.Size()))
-		n18, err := m.IoStat.MarshalTo(data[i:])
+		n23, err := m.IoStat.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n23
 	}
 	if len(m.ContainerId) > 0 {
 		data[i] = 0x72
@@ -1794,11 +2257,11 @@ func (m *Container) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n19, err := m.Host.MarshalTo(data[i:])
+		n24, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n24
 	}
 	if m.Started != 0 {
 		data[i] = 0xc0
@@ -1832,6 +2295,219 @@ func (m *Container) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
+	if len(m.ImageName) > 0 {
+		data[i] = 0xda
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.ImageName)))
+		i += copy(data[i:], m.ImageName)
+	}
+	if len(m.ImageTag) > 0 {
+		data[i] = 0xe2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.ImageTag)))
+		i += copy(data[i:], m.ImageTag)
+	}
+	if len(m.ImageDigest) > 0 {
+		data[i] = 0xea
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.ImageDigest)))
+		i += copy(data[i:], m.ImageDigest)
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			data[i] = 0xf2
+			i++
+			data[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Entrypoint) > 0 {
+		for _, s := range m.Entrypoint {
+			data[i] = 0xfa
+			i++
+			data[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, _ := range m.Labels {
+			data[i] = 0x82
+			i++
+			data[i] = 0x2
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			i = encodeVarintAgent(data, i, uint64(mapSize))
+			data[i] = 0xa
+			i++
+			i = encodeVarintAgent(data, i, uint64(len(k)))
+			i += copy(data[i:], k)
+			data[i] = 0x12
+			i++
+			i = encodeVarintAgent(data, i, uint64(len(v)))
+			i += copy(data[i:], v)
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			data[i] = 0x8a
+			i++
+			data[i] = 0x2
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ProcessCount != 0 {
+		data[i] = 0x90
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcessCount))
+	}
+	if m.ThreadCount != 0 {
+		data[i] = 0x98
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ThreadCount))
+	}
+	if m.OpenFdCount != 0 {
+		data[i] = 0xa0
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.OpenFdCount))
+	}
+	if len(m.TopCpuProcesses) > 0 {
+		for _, msg := range m.TopCpuProcesses {
+			data[i] = 0xaa
+			i++
+			data[i] = 0x2
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.TopMemProcesses) > 0 {
+		for _, msg := range m.TopMemProcesses {
+			data[i] = 0xb2
+			i++
+			data[i] = 0x2
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ContainerPort) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ContainerPort) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Port != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Port))
+	}
+	if len(m.Protocol) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Protocol)))
+		i += copy(data[i:], m.Protocol)
+	}
+	return i, nil
+}
+
+func (m *ContainerProcess) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ContainerProcess) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
+	}
+	if m.CreateTime != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.CreateTime))
+	}
+	if len(m.Name) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if m.CpuPct != 0 {
+		data[i] = 0x25
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.CpuPct))))
+	}
+	if m.MemRss != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemRss))
+	}
 	return i, nil
 }
 
@@ -1864,21 +2540,21 @@ func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Memory.Size()))
-		n20, err := m.Memory.MarshalTo(data[i:])
+		n25, err := m.Memory.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n25
 	}
 	if m.Cpu != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Cpu.Size()))
-		n21, err := m.Cpu.MarshalTo(data[i:])
+		n26, err := m.Cpu.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n26
 	}
 	if m.Nice != 0 {
 		data[i] = 0x28
@@ -1948,11 +2624,11 @@ func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.IoStat.Size()))
-		n22, err := m.IoStat.MarshalTo(data[i:])
+		n27, err := m.IoStat.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n27
 	}
 	if m.ContainerNetRcvdPs != 0 {
 		data[i] = 0xa5
@@ -2163,11 +2839,11 @@ func (m *SystemInfo) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Os.Size()))
-		n23, err := m.Os.MarshalTo(data[i:])
+		n28, err := m.Os.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n28
 	}
 	if len(m.Cpus) > 0 {
 		for _, msg := range m.Cpus {
@@ -2186,10 +2862,15 @@ func (m *SystemInfo) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.TotalMemory))
 	}
+	if m.Sequence != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Sequence))
+	}
 	return i, nil
 }
 
-func (m *OSInfo) Marshal() (data []byte, err error) {
+func (m *SystemStats) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2199,94 +2880,341 @@ func (m *OSInfo) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *OSInfo) MarshalTo(data []byte) (int, error) {
+func (m *SystemStats) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		data[i] = 0xa
+	if m.Load1 != 0 {
+		data[i] = 0xd
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
-		i += copy(data[i:], m.Name)
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Load1))))
 	}
-	if len(m.Platform) > 0 {
-		data[i] = 0x12
+	if m.Load5 != 0 {
+		data[i] = 0x15
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Platform)))
-		i += copy(data[i:], m.Platform)
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Load5))))
 	}
-	if len(m.Family) > 0 {
-		data[i] = 0x1a
+	if m.Load15 != 0 {
+		data[i] = 0x1d
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Family)))
-		i += copy(data[i:], m.Family)
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Load15))))
 	}
-	if len(m.Version) > 0 {
-		data[i] = 0x22
+	if m.UserPct != 0 {
+		data[i] = 0x25
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Version)))
-		i += copy(data[i:], m.Version)
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.UserPct))))
 	}
-	if len(m.KernelVersion) > 0 {
-		data[i] = 0x2a
+	if m.SystemPct != 0 {
+		data[i] = 0x2d
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.KernelVersion)))
-		i += copy(data[i:], m.KernelVersion)
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SystemPct))))
 	}
-	return i, nil
-}
-
-func (m *IOStat) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if m.IdlePct != 0 {
+		data[i] = 0x35
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.IdlePct))))
 	}
-	return data[:n], nil
-}
-
-func (m *IOStat) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ReadRate != 0 {
-		data[i] = 0xd
+	if m.NicePct != 0 {
+		data[i] = 0x3d
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.ReadRate))))
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NicePct))))
 	}
-	if m.WriteRate != 0 {
-		data[i] = 0x15
+	if m.IowaitPct != 0 {
+		data[i] = 0x45
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.WriteRate))))
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.IowaitPct))))
 	}
-	if m.ReadBytesRate != 0 {
-		data[i] = 0x1d
+	if m.IrqPct != 0 {
+		data[i] = 0x4d
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.ReadBytesRate))))
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.IrqPct))))
 	}
-	if m.WriteBytesRate != 0 {
-		data[i] = 0x25
+	if m.SoftirqPct != 0 {
+		data[i] = 0x55
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.WriteBytesRate))))
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SoftirqPct))))
 	}
-	return i, nil
-}
-
-func (m *Connection) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if m.StealPct != 0 {
+		data[i] = 0x5d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.StealPct))))
 	}
-	return data[:n], nil
-}
-
-func (m *Connection) MarshalTo(data []byte) (int, error) {
-	var i int
+	if m.GuestPct != 0 {
+		data[i] = 0x65
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.GuestPct))))
+	}
+	if m.MemTotal != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemTotal))
+	}
+	if m.MemAvailable != 0 {
+		data[i] = 0x70
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemAvailable))
+	}
+	if m.MemUsed != 0 {
+		data[i] = 0x78
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemUsed))
+	}
+	if m.MemFree != 0 {
+		data[i] = 0x80
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemFree))
+	}
+	if m.MemBuffers != 0 {
+		data[i] = 0x88
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemBuffers))
+	}
+	if m.MemCached != 0 {
+		data[i] = 0x90
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemCached))
+	}
+	if m.MemShared != 0 {
+		data[i] = 0x98
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemShared))
+	}
+	if m.MemSlab != 0 {
+		data[i] = 0xa0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemSlab))
+	}
+	if m.SwapTotal != 0 {
+		data[i] = 0xa8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SwapTotal))
+	}
+	if m.SwapUsed != 0 {
+		data[i] = 0xb0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SwapUsed))
+	}
+	if m.SwapFree != 0 {
+		data[i] = 0xb8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SwapFree))
+	}
+	if m.SwapInBps != 0 {
+		data[i] = 0xc5
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SwapInBps))))
+	}
+	if m.SwapOutBps != 0 {
+		data[i] = 0xcd
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SwapOutBps))))
+	}
+	if m.CtxSwitchesPs != 0 {
+		data[i] = 0xd5
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.CtxSwitchesPs))))
+	}
+	if m.ForksPs != 0 {
+		data[i] = 0xdd
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.ForksPs))))
+	}
+	if m.ProcsTotal != 0 {
+		data[i] = 0xe0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsTotal))
+	}
+	if m.ProcsRunning != 0 {
+		data[i] = 0xe8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsRunning))
+	}
+	if m.ProcsSleeping != 0 {
+		data[i] = 0xf0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsSleeping))
+	}
+	if m.ProcsBlocked != 0 {
+		data[i] = 0xf8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsBlocked))
+	}
+	if m.ProcsStopped != 0 {
+		data[i] = 0x80
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsStopped))
+	}
+	if m.ProcsZombie != 0 {
+		data[i] = 0x88
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsZombie))
+	}
+	if m.ProcsIdle != 0 {
+		data[i] = 0x90
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ProcsIdle))
+	}
+	if m.ThreadsTotal != 0 {
+		data[i] = 0x98
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.ThreadsTotal))
+	}
+	if m.OpenFds != 0 {
+		data[i] = 0xa0
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.OpenFds))
+	}
+	if m.MaxFds != 0 {
+		data[i] = 0xa8
+		i++
+		data[i] = 0x2
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MaxFds))
+	}
+	return i, nil
+}
+
+func (m *OSInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *OSInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if len(m.Platform) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Platform)))
+		i += copy(data[i:], m.Platform)
+	}
+	if len(m.Family) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Family)))
+		i += copy(data[i:], m.Family)
+	}
+	if len(m.Version) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Version)))
+		i += copy(data[i:], m.Version)
+	}
+	if len(m.KernelVersion) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.KernelVersion)))
+		i += copy(data[i:], m.KernelVersion)
+	}
+	return i, nil
+}
+
+func (m *IOStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *IOStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ReadRate != 0 {
+		data[i] = 0xd
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.ReadRate))))
+	}
+	if m.WriteRate != 0 {
+		data[i] = 0x15
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.WriteRate))))
+	}
+	if m.ReadBytesRate != 0 {
+		data[i] = 0x1d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.ReadBytesRate))))
+	}
+	if m.WriteBytesRate != 0 {
+		data[i] = 0x25
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.WriteBytesRate))))
+	}
+	return i, nil
+}
+
+func (m *Connection) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Connection) MarshalTo(data []byte) (int, error) {
+	var i int
 	_ = i
 	var l int
 	_ = l
@@ -2314,21 +3242,21 @@ func (m *Connection) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Laddr.Size()))
-		n24, err := m.Laddr.MarshalTo(data[i:])
+		n29, err := m.Laddr.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n29
 	}
 	if m.Raddr != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Raddr.Size()))
-		n25, err := m.Raddr.MarshalTo(data[i:])
+		n30, err := m.Raddr.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n30
 	}
 	if len(m.Status) > 0 {
 		data[i] = 0x3a
@@ -2358,11 +3286,11 @@ func (m *Addr) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n26, err := m.Host.MarshalTo(data[i:])
+		n31, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n31
 	}
 	if len(m.Ip) > 0 {
 		data[i] = 0x12
@@ -2808,6 +3736,13 @@ func (m *CollectorProc) Size() (n int) {
 			n += 1 + l + sovAgent(uint64(l))
 		}
 	}
+	if m.Omitted != nil {
+		l = m.Omitted.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Delta {
+		n += 2
+	}
 	return n
 }
 
@@ -2868,6 +3803,34 @@ func (m *CollectorRealTime) Size() (n int) {
 			n += 1 + l + sovAgent(uint64(l))
 		}
 	}
+	if m.Omitted != nil {
+		l = m.Omitted.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *OmittedProcesses) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovAgent(uint64(m.Count))
+	}
+	if m.TotalCpuPct != 0 {
+		n += 5
+	}
+	if m.TotalRss != 0 {
+		n += 1 + sovAgent(uint64(m.TotalRss))
+	}
+	if m.TotalReadBytesRate != 0 {
+		n += 5
+	}
+	if m.TotalWriteBytesRate != 0 {
+		n += 5
+	}
+	if m.TotalOpenFdCount != 0 {
+		n += 1 + sovAgent(uint64(m.TotalOpenFdCount))
+	}
 	return n
 }
 
@@ -2940,6 +3903,30 @@ func (m *CollectorContainerRealTime) Size() (n int) {
 	return n
 }
 
+func (m *CollectorSystemStats) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
+	}
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
 func (m *CollectorReqStatus) Size() (n int) {
 	var l int
 	_ = l
@@ -2959,16 +3946,54 @@ func (m *CollectorStatus) Size() (n int) {
 	if m.Interval != 0 {
 		n += 1 + sovAgent(uint64(m.Interval))
 	}
+	if m.Settings != nil {
+		l = m.Settings.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Resync {
+		n += 2
+	}
 	return n
 }
 
-func (m *Process) Size() (n int) {
+func (m *RemoteSettings) Size() (n int) {
 	var l int
 	_ = l
-	if m.Key != 0 {
-		n += 1 + sovAgent(uint64(m.Key))
+	if len(m.CheckIntervals) > 0 {
+		for k, v := range m.CheckIntervals {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + sovAgent(uint64(v))
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
 	}
-	if m.Pid != 0 {
+	if len(m.Checks) > 0 {
+		for k, v := range m.Checks {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if m.ProcLimit != 0 {
+		n += 1 + sovAgent(uint64(m.ProcLimit))
+	}
+	if len(m.BlacklistPatterns) > 0 {
+		for _, s := range m.BlacklistPatterns {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Process) Size() (n int) {
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovAgent(uint64(m.Key))
+	}
+	if m.Pid != 0 {
 		n += 1 + sovAgent(uint64(m.Pid))
 	}
 	if m.Host != nil {
@@ -3180,6 +4205,100 @@ func (m *Container) Size() (n int) {
 			n += 2 + l + sovAgent(uint64(l))
 		}
 	}
+	l = len(m.ImageName)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ImageTag)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ImageDigest)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Entrypoint) > 0 {
+		for _, s := range m.Entrypoint {
+			l = len(s)
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAgent(uint64(len(k))) + 1 + len(v) + sovAgent(uint64(len(v)))
+			n += mapEntrySize + 2 + sovAgent(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.ProcessCount != 0 {
+		n += 2 + sovAgent(uint64(m.ProcessCount))
+	}
+	if m.ThreadCount != 0 {
+		n += 2 + sovAgent(uint64(m.ThreadCount))
+	}
+	if m.OpenFdCount != 0 {
+		n += 2 + sovAgent(uint64(m.OpenFdCount))
+	}
+	if len(m.TopCpuProcesses) > 0 {
+		for _, e := range m.TopCpuProcesses {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.TopMemProcesses) > 0 {
+		for _, e := range m.TopMemProcesses {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerPort) Size() (n int) {
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovAgent(uint64(m.Port))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerProcess) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.CpuPct != 0 {
+		n += 5
+	}
+	if m.MemRss != 0 {
+		n += 1 + sovAgent(uint64(m.MemRss))
+	}
 	return n
 }
 
@@ -3352,6 +4471,126 @@ func (m *SystemInfo) Size() (n int) {
 	if m.TotalMemory != 0 {
 		n += 1 + sovAgent(uint64(m.TotalMemory))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovAgent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *SystemStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Load1 != 0 {
+		n += 5
+	}
+	if m.Load5 != 0 {
+		n += 5
+	}
+	if m.Load15 != 0 {
+		n += 5
+	}
+	if m.UserPct != 0 {
+		n += 5
+	}
+	if m.SystemPct != 0 {
+		n += 5
+	}
+	if m.IdlePct != 0 {
+		n += 5
+	}
+	if m.NicePct != 0 {
+		n += 5
+	}
+	if m.IowaitPct != 0 {
+		n += 5
+	}
+	if m.IrqPct != 0 {
+		n += 5
+	}
+	if m.SoftirqPct != 0 {
+		n += 5
+	}
+	if m.StealPct != 0 {
+		n += 5
+	}
+	if m.GuestPct != 0 {
+		n += 5
+	}
+	if m.MemTotal != 0 {
+		n += 1 + sovAgent(uint64(m.MemTotal))
+	}
+	if m.MemAvailable != 0 {
+		n += 1 + sovAgent(uint64(m.MemAvailable))
+	}
+	if m.MemUsed != 0 {
+		n += 1 + sovAgent(uint64(m.MemUsed))
+	}
+	if m.MemFree != 0 {
+		n += 2 + sovAgent(uint64(m.MemFree))
+	}
+	if m.MemBuffers != 0 {
+		n += 2 + sovAgent(uint64(m.MemBuffers))
+	}
+	if m.MemCached != 0 {
+		n += 2 + sovAgent(uint64(m.MemCached))
+	}
+	if m.MemShared != 0 {
+		n += 2 + sovAgent(uint64(m.MemShared))
+	}
+	if m.MemSlab != 0 {
+		n += 2 + sovAgent(uint64(m.MemSlab))
+	}
+	if m.SwapTotal != 0 {
+		n += 2 + sovAgent(uint64(m.SwapTotal))
+	}
+	if m.SwapUsed != 0 {
+		n += 2 + sovAgent(uint64(m.SwapUsed))
+	}
+	if m.SwapFree != 0 {
+		n += 2 + sovAgent(uint64(m.SwapFree))
+	}
+	if m.SwapInBps != 0 {
+		n += 6
+	}
+	if m.SwapOutBps != 0 {
+		n += 6
+	}
+	if m.CtxSwitchesPs != 0 {
+		n += 6
+	}
+	if m.ForksPs != 0 {
+		n += 6
+	}
+	if m.ProcsTotal != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsTotal))
+	}
+	if m.ProcsRunning != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsRunning))
+	}
+	if m.ProcsSleeping != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsSleeping))
+	}
+	if m.ProcsBlocked != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsBlocked))
+	}
+	if m.ProcsStopped != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsStopped))
+	}
+	if m.ProcsZombie != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsZombie))
+	}
+	if m.ProcsIdle != 0 {
+		n += 2 + sovAgent(uint64(m.ProcsIdle))
+	}
+	if m.ThreadsTotal != 0 {
+		n += 2 + sovAgent(uint64(m.ThreadsTotal))
+	}
+	if m.OpenFds != 0 {
+		n += 2 + sovAgent(uint64(m.OpenFds))
+	}
+	if m.MaxFds != 0 {
+		n += 2 + sovAgent(uint64(m.MaxFds))
+	}
 	return n
 }
 
@@ -4130,6 +5369,59 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Omitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Omitted == nil {
+				m.Omitted = &OmittedProcesses{}
+			}
+			if err := m.Omitted.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delta = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -4528,6 +5820,39 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Omitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Omitted == nil {
+				m.Omitted = &OmittedProcesses{}
+			}
+			if err := m.Omitted.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -4549,7 +5874,7 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorContainer) Unmarshal(data []byte) error {
+func (m *OmittedProcesses) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4572,17 +5897,17 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorContainer: wiretype end group for non-group")
+			return fmt.Errorf("proto: OmittedProcesses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OmittedProcesses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4592,26 +5917,30 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Count |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCpuPct", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostName = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalCpuPct = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRss", wireType)
 			}
-			var msglen int
+			m.TotalRss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4621,15 +5950,160 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TotalRss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReadBytesRate", wireType)
 			}
-			postIndex := iNdEx + msglen
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalReadBytesRate = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWriteBytesRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalWriteBytesRate = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOpenFdCount", wireType)
+			}
+			m.TotalOpenFdCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TotalOpenFdCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorContainer) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -5034,7 +6508,7 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorReqStatus) Unmarshal(data []byte) error {
+func (m *CollectorSystemStats) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5057,13 +6531,13 @@ func (m *CollectorReqStatus) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorReqStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectorSystemStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorReqStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectorSystemStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
 			}
@@ -5092,6 +6566,110 @@ func (m *CollectorReqStatus) Unmarshal(data []byte) error {
 			}
 			m.HostName = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &SystemStats{}
+			}
+			if err := m.Stats.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -5113,7 +6691,7 @@ func (m *CollectorReqStatus) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorStatus) Unmarshal(data []byte) error {
+func (m *CollectorReqStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5136,36 +6714,17 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectorReqStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectorReqStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
-			}
-			m.ActiveClients = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.ActiveClients |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
 			}
-			m.Interval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5175,11 +6734,21 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Interval |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -5201,7 +6770,7 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Process) Unmarshal(data []byte) error {
+func (m *CollectorStatus) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5224,17 +6793,17 @@ func (m *Process) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
 			}
-			m.Key = 0
+			m.ActiveClients = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5244,16 +6813,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				m.ActiveClients |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.Pid = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5263,14 +6832,14 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Pid |= (int32(b) & 0x7F) << shift
+				m.Interval |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5294,18 +6863,18 @@ func (m *Process) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Host == nil {
-				m.Host = &Host{}
+			if m.Settings == nil {
+				m.Settings = &RemoteSettings{}
 			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Settings.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resync", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5315,28 +6884,65 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Resync = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Command == nil {
-				m.Command = &Command{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSettings) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckIntervals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5360,18 +6966,22 @@ func (m *Process) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.User == nil {
-				m.User = &ProcessUser{}
-			}
-			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5381,28 +6991,64 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &MemoryStat{}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.CheckIntervals == nil {
+				m.CheckIntervals = make(map[string]int32)
 			}
-			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CheckIntervals[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.CheckIntervals[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5426,18 +7072,7 @@ func (m *Process) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CPUStat{}
-			}
-			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
-			}
-			m.CreateTime = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5447,16 +7082,12 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.CreateTime |= (int64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
-			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5466,49 +7097,67 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Checks == nil {
+				m.Checks = make(map[string]bool)
 			}
-			m.OpenFdCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := data[iNdEx]
-				iNdEx++
-				m.OpenFdCount |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				var mapvaluetemp int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					mapvaluetemp |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
+				mapvalue := bool(mapvaluetemp != 0)
+				m.Checks[mapkey] = mapvalue
+			} else {
+				var mapvalue bool
+				m.Checks[mapkey] = mapvalue
 			}
-		case 12:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcLimit", wireType)
 			}
-			m.State = 0
+			m.ProcLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5518,16 +7167,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ProcessState(b) & 0x7F) << shift
+				m.ProcLimit |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistPatterns", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5537,78 +7186,76 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IoStat == nil {
-				m.IoStat = &IOStat{}
-			}
-			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlacklistPatterns = append(m.BlacklistPatterns, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Process) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			m.ContainerKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.ContainerKey |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 16:
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.VoluntaryCtxSwitches = 0
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5618,16 +7265,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			m.InvoluntaryCtxSwitches = 0
+			m.Pid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5637,16 +7284,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.Pid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5656,28 +7303,30 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5687,78 +7336,30 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ContainerByteKey == nil {
-				m.ContainerByteKey = []byte{}
+			if m.Command == nil {
+				m.Command = &Command{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
+			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Command) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5768,26 +7369,30 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(data[iNdEx:postIndex]))
+			if m.User == nil {
+				m.User = &ProcessUser{}
+			}
+			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5797,26 +7402,30 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cwd = string(data[iNdEx:postIndex])
+			if m.Memory == nil {
+				m.Memory = &MemoryStat{}
+			}
+			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5826,26 +7435,30 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = string(data[iNdEx:postIndex])
+			if m.Cpu == nil {
+				m.Cpu = &CPUStat{}
+			}
+			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			var v int
+			m.CreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5855,17 +7468,49 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CreateTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OnDisk = bool(v != 0)
-		case 6:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ppid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
 			}
-			m.Ppid = 0
+			m.OpenFdCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5875,16 +7520,16 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Ppid |= (int32(b) & 0x7F) << shift
+				m.OpenFdCount |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pgroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Pgroup = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5894,16 +7539,16 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Pgroup |= (int32(b) & 0x7F) << shift
+				m.State |= (ProcessState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5913,74 +7558,28 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exe = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProcessUser) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.IoStat == nil {
+				m.IoStat = &IOStat{}
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessUser: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessUser: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6005,13 +7604,13 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[iNdEx:postIndex])
+			m.ContainerId = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
 			}
-			m.Uid = 0
+			m.ContainerKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6021,16 +7620,16 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Uid |= (int32(b) & 0x7F) << shift
+				m.ContainerKey |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
 			}
-			m.Gid = 0
+			m.VoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6040,16 +7639,16 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Gid |= (int32(b) & 0x7F) << shift
+				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Euid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
 			}
-			m.Euid = 0
+			m.InvoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6059,16 +7658,16 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Euid |= (int32(b) & 0x7F) << shift
+				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egid", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
-			m.Egid = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6078,35 +7677,28 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Egid |= (int32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suid", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Suid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Suid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sgid", wireType)
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
 			}
-			m.Sgid = 0
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6116,11 +7708,23 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Sgid |= (int32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ContainerByteKey == nil {
+				m.ContainerByteKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -6142,7 +7746,7 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(data []byte) error {
+func (m *Command) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6165,15 +7769,15 @@ func (m *Container) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6198,11 +7802,11 @@ func (m *Container) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(data[iNdEx:postIndex])
+			m.Args = append(m.Args, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6227,11 +7831,11 @@ func (m *Container) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(data[iNdEx:postIndex])
+			m.Cwd = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6256,13 +7860,13 @@ func (m *Container) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[iNdEx:postIndex])
+			m.Root = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDisk", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6272,40 +7876,1698 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.OnDisk = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ppid", wireType)
 			}
-			m.Image = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
+			m.Ppid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ppid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pgroup", wireType)
+			}
+			m.Pgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pgroup |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exe = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessUser) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessUser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessUser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Uid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			}
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Gid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Euid", wireType)
+			}
+			m.Euid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Euid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egid", wireType)
+			}
+			m.Egid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Egid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suid", wireType)
+			}
+			m.Suid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Suid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sgid", wireType)
+			}
+			m.Sgid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sgid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Container) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.CpuLimit = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (ContainerState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			m.Health = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Rbps = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Wbps = float32(math.Float32frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Key |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdPs = float32(math.Float32frombits(v))
+		case 15:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentPs = float32(math.Float32frombits(v))
+		case 16:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdBps = float32(math.Float32frombits(v))
+		case 17:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentBps = float32(math.Float32frombits(v))
+		case 18:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.UserPct = float32(math.Float32frombits(v))
+		case 19:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.SystemPct = float32(math.Float32frombits(v))
+		case 20:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalPct = float32(math.Float32frombits(v))
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+			}
+			m.MemRss = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemRss |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
+			}
+			m.MemCache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemCache |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			m.Started = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Started |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageTag = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageDigest = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entrypoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entrypoint = append(m.Entrypoint, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(data[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAgent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := data[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthAgent
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Labels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Labels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &ContainerPort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessCount", wireType)
+			}
+			m.ProcessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ProcessCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadCount", wireType)
+			}
+			m.ThreadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ThreadCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+			}
+			m.OpenFdCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopCpuProcesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopCpuProcesses = append(m.TopCpuProcesses, &ContainerProcess{})
+			if err := m.TopCpuProcesses[len(m.TopCpuProcesses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopMemProcesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopMemProcesses = append(m.TopMemProcesses, &ContainerProcess{})
+			if err := m.TopMemProcesses[len(m.TopMemProcesses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerPort) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerPort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerPort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Port |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerProcess) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerProcess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerProcess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.CpuLimit = float32(math.Float32frombits(v))
-		case 6:
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.CpuPct = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+			}
+			m.MemRss = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemRss |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStat) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memory == nil {
+				m.Memory = &MemoryStat{}
+			}
+			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cpu == nil {
+				m.Cpu = &CPUStat{}
+			}
+			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+			}
+			m.Nice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Nice |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			m.Threads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Threads |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+			}
+			m.OpenFdCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.MemoryLimit = 0
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6315,16 +9577,45 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerState", wireType)
 			}
-			m.State = 0
+			m.ContainerState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6334,16 +9625,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
+				m.ContainerState |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessState", wireType)
 			}
-			m.Health = 0
+			m.ProcessState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6353,16 +9644,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				m.ProcessState |= (ProcessState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHealth", wireType)
 			}
-			m.Created = 0
+			m.ContainerHealth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6372,14 +9663,14 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Created |= (int64(b) & 0x7F) << shift
+				m.ContainerHealth |= (ContainerHealth(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 16:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRbps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6390,10 +9681,10 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.Rbps = float32(math.Float32frombits(v))
-		case 12:
+			m.ContainerRbps = float32(math.Float32frombits(v))
+		case 17:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerWbps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6404,12 +9695,12 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.Wbps = float32(math.Float32frombits(v))
-		case 13:
+			m.ContainerWbps = float32(math.Float32frombits(v))
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
 			}
-			m.Key = 0
+			m.ContainerKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6419,56 +9710,47 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				m.ContainerKey |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdPs = float32(math.Float32frombits(v))
-		case 15:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentPs = float32(math.Float32frombits(v))
-		case 16:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			if m.IoStat == nil {
+				m.IoStat = &IOStat{}
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdBps = float32(math.Float32frombits(v))
-		case 17:
+			iNdEx = postIndex
+		case 20:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdPs", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6479,10 +9761,10 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentBps = float32(math.Float32frombits(v))
-		case 18:
+			m.ContainerNetRcvdPs = float32(math.Float32frombits(v))
+		case 21:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentPs", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6493,10 +9775,10 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.UserPct = float32(math.Float32frombits(v))
-		case 19:
+			m.ContainerNetSentPs = float32(math.Float32frombits(v))
+		case 22:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdBps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6507,10 +9789,10 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.SystemPct = float32(math.Float32frombits(v))
-		case 20:
+			m.ContainerNetRcvdBps = float32(math.Float32frombits(v))
+		case 23:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentBps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6521,50 +9803,12 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.TotalPct = float32(math.Float32frombits(v))
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
-			}
-			m.MemRss = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.MemRss |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
+			m.ContainerNetSentBps = float32(math.Float32frombits(v))
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
-			}
-			m.MemCache = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.MemCache |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
 			}
-			var msglen int
+			m.VoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6574,30 +9818,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
 			}
-			m.Started = 0
+			m.InvoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6607,12 +9837,12 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Started |= (int64(b) & 0x7F) << shift
+				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+		case 26:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
@@ -6643,11 +9873,11 @@ func (m *Container) Unmarshal(data []byte) error {
 				m.ByteKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 26:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6657,20 +9887,22 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(data[iNdEx:postIndex]))
+			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ContainerByteKey == nil {
+				m.ContainerByteKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6693,7 +9925,7 @@ func (m *Container) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStat) Unmarshal(data []byte) error {
+func (m *ContainerStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6716,17 +9948,17 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Pid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6736,16 +9968,82 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Pid |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.UserPct = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.SystemPct = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalPct = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.CpuLimit = float32(math.Float32frombits(v))
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
 			}
-			m.CreateTime = 0
+			m.MemRss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6755,16 +10053,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.CreateTime |= (int64(b) & 0x7F) << shift
+				m.MemRss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
 			}
-			var msglen int
+			m.MemCache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6774,30 +10072,119 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MemCache |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			m.MemLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &MemoryStat{}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Rbps = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
 			}
-			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Wbps = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
 			}
-			var msglen int
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdPs = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentPs = float32(math.Float32frombits(v))
+		case 13:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdBps = float32(math.Float32frombits(v))
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentBps = float32(math.Float32frombits(v))
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6807,30 +10194,35 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.State |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cpu == nil {
-				m.Cpu = &CPUStat{}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Health = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Nice = 0
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6840,16 +10232,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Nice |= (int32(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.Threads = 0
+			m.Started = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6859,16 +10251,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Threads |= (int32(b) & 0x7F) << shift
+				m.Started |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
-			m.OpenFdCount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6878,16 +10270,78 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Key = 0
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SystemInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6897,16 +10351,26 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6916,26 +10380,30 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(data[iNdEx:postIndex])
+			if m.Os == nil {
+				m.Os = &OSInfo{}
+			}
+			if err := m.Os.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerState", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
 			}
-			m.ContainerState = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6945,16 +10413,28 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerState |= (ContainerState(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cpus = append(m.Cpus, &CPUInfo{})
+			if err := m.Cpus[len(m.Cpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
 			}
-			m.ProcessState = 0
+			m.TotalMemory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6964,16 +10444,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ProcessState |= (ProcessState(b) & 0x7F) << shift
+				m.TotalMemory |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHealth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.ContainerHealth = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6983,14 +10463,120 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerHealth |= (ContainerHealth(b) & 0x7F) << shift
+				m.Sequence |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SystemStats) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load1", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Load1 = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load5", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Load5 = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load15", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Load15 = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.UserPct = float32(math.Float32frombits(v))
+		case 5:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7001,10 +10587,10 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerRbps = float32(math.Float32frombits(v))
-		case 17:
+			m.SystemPct = float32(math.Float32frombits(v))
+		case 6:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerWbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdlePct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7015,62 +10601,38 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerWbps = float32(math.Float32frombits(v))
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
-			}
-			m.ContainerKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.ContainerKey |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+			m.IdlePct = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NicePct", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IoStat == nil {
-				m.IoStat = &IOStat{}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NicePct = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IowaitPct", wireType)
 			}
-			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 20:
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.IowaitPct = float32(math.Float32frombits(v))
+		case 9:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IrqPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7081,10 +10643,10 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetRcvdPs = float32(math.Float32frombits(v))
-		case 21:
+			m.IrqPct = float32(math.Float32frombits(v))
+		case 10:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftirqPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7095,10 +10657,10 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetSentPs = float32(math.Float32frombits(v))
-		case 22:
+			m.SoftirqPct = float32(math.Float32frombits(v))
+		case 11:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StealPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7109,10 +10671,10 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetRcvdBps = float32(math.Float32frombits(v))
-		case 23:
+			m.StealPct = float32(math.Float32frombits(v))
+		case 12:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuestPct", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7123,12 +10685,12 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetSentBps = float32(math.Float32frombits(v))
-		case 24:
+			m.GuestPct = float32(math.Float32frombits(v))
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemTotal", wireType)
 			}
-			m.VoluntaryCtxSwitches = 0
+			m.MemTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7138,16 +10700,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.MemTotal |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemAvailable", wireType)
 			}
-			m.InvoluntaryCtxSwitches = 0
+			m.MemAvailable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7157,16 +10719,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.MemAvailable |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemUsed", wireType)
 			}
-			var byteLen int
+			m.MemUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7176,28 +10738,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.MemUsed |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemFree", wireType)
 			}
-			var byteLen int
+			m.MemFree = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7207,78 +10757,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.MemFree |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ContainerByteKey == nil {
-				m.ContainerByteKey = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerStat) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStat: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStat: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemBuffers", wireType)
 			}
-			var stringLen uint64
+			m.MemBuffers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7288,82 +10776,35 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MemBuffers |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.UserPct = float32(math.Float32frombits(v))
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.SystemPct = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.TotalPct = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCached", wireType)
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.CpuLimit = float32(math.Float32frombits(v))
-		case 6:
+			m.MemCached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.MemCached |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemShared", wireType)
 			}
-			m.MemRss = 0
+			m.MemShared = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7373,16 +10814,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemRss |= (uint64(b) & 0x7F) << shift
+				m.MemShared |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemSlab", wireType)
 			}
-			m.MemCache = 0
+			m.MemSlab = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7392,16 +10833,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemCache |= (uint64(b) & 0x7F) << shift
+				m.MemSlab |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTotal", wireType)
 			}
-			m.MemLimit = 0
+			m.SwapTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7411,42 +10852,52 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemLimit |= (uint64(b) & 0x7F) << shift
+				m.SwapTotal |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsed", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.SwapUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.SwapUsed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Rbps = float32(math.Float32frombits(v))
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFree", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.SwapFree = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.SwapFree |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Wbps = float32(math.Float32frombits(v))
-		case 11:
+		case 24:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInBps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7457,10 +10908,10 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdPs = float32(math.Float32frombits(v))
-		case 12:
+			m.SwapInBps = float32(math.Float32frombits(v))
+		case 25:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutBps", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7471,10 +10922,10 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentPs = float32(math.Float32frombits(v))
-		case 13:
+			m.SwapOutBps = float32(math.Float32frombits(v))
+		case 26:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CtxSwitchesPs", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7485,10 +10936,10 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdBps = float32(math.Float32frombits(v))
-		case 14:
+			m.CtxSwitchesPs = float32(math.Float32frombits(v))
+		case 27:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForksPs", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -7499,12 +10950,12 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentBps = float32(math.Float32frombits(v))
-		case 15:
+			m.ForksPs = float32(math.Float32frombits(v))
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsTotal", wireType)
 			}
-			m.State = 0
+			m.ProcsTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7514,16 +10965,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
+				m.ProcsTotal |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 29:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsRunning", wireType)
 			}
-			m.Health = 0
+			m.ProcsRunning = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7533,16 +10984,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				m.ProcsRunning |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsSleeping", wireType)
 			}
-			m.Key = 0
+			m.ProcsSleeping = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7552,16 +11003,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				m.ProcsSleeping |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+		case 31:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsBlocked", wireType)
 			}
-			m.Started = 0
+			m.ProcsBlocked = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7571,16 +11022,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Started |= (int64(b) & 0x7F) << shift
+				m.ProcsBlocked |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsStopped", wireType)
 			}
-			var byteLen int
+			m.ProcsStopped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7590,78 +11041,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.ProcsStopped |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SystemInfo) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SystemInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsZombie", wireType)
 			}
-			var stringLen uint64
+			m.ProcsZombie = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7671,26 +11060,16 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ProcsZombie |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuid = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcsIdle", wireType)
 			}
-			var msglen int
+			m.ProcsIdle = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7700,30 +11079,16 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ProcsIdle |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Os == nil {
-				m.Os = &OSInfo{}
-			}
-			if err := m.Os.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadsTotal", wireType)
 			}
-			var msglen int
+			m.ThreadsTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7733,28 +11098,35 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ThreadsTotal |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFds", wireType)
 			}
-			m.Cpus = append(m.Cpus, &CPUInfo{})
-			if err := m.Cpus[len(m.Cpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.OpenFds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OpenFds |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 37:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFds", wireType)
 			}
-			m.TotalMemory = 0
+			m.MaxFds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7764,7 +11136,7 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				m.MaxFds |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9658,157 +13030,217 @@ var (
 func init() { proto.RegisterFile("agent.proto", fileDescriptorAgent) }
 
 var fileDescriptorAgent = []byte{
-	// 2430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
-	0x11, 0xd6, 0xbc, 0xf6, 0x51, 0xe4, 0x52, 0xab, 0x16, 0x25, 0x8f, 0x69, 0x85, 0xa1, 0x27, 0x8e,
-	0xc1, 0x08, 0x10, 0xa5, 0xc8, 0x89, 0x21, 0x3b, 0x81, 0xe2, 0x88, 0x8a, 0x23, 0xc2, 0x96, 0x4c,
-	0xf4, 0x4a, 0x71, 0xe0, 0x1c, 0x8c, 0xe1, 0x4c, 0x73, 0x39, 0xe0, 0xce, 0x23, 0xf3, 0x20, 0xb5,
-	0x3e, 0xe5, 0x27, 0xf8, 0x92, 0x83, 0x8f, 0x39, 0x04, 0x48, 0x80, 0xdc, 0xf3, 0x0f, 0x82, 0x20,
-	0xb9, 0x04, 0x39, 0x25, 0xb7, 0x40, 0x81, 0xff, 0x47, 0x50, 0xd5, 0x3d, 0x8f, 0x7d, 0xf2, 0x11,
-	0x9f, 0xb6, 0xab, 0xba, 0xaa, 0xbb, 0xa7, 0xbb, 0xbe, 0xaf, 0xaa, 0x7b, 0x61, 0xc5, 0x1d, 0x8a,
-	0x28, 0xdf, 0x49, 0xd2, 0x38, 0x8f, 0xd9, 0x0d, 0xdf, 0xcd, 0x5d, 0x3f, 0x1e, 0xa2, 0xe8, 0x89,
-	0x2c, 0xfb, 0x9c, 0x3a, 0x37, 0x7e, 0x30, 0x0c, 0xf2, 0xa3, 0xe2, 0x60, 0xc7, 0x8b, 0xc3, 0xbb,
-	0x8f, 0xdd, 0xdc, 0x7d, 0x1c, 0x0f, 0xef, 0x52, 0xcf, 0x9d, 0xc4, 0x1d, 0x8f, 0x62, 0xd7, 0x97,
-	0xd2, 0xe7, 0x4a, 0x92, 0x83, 0x39, 0x7f, 0xd3, 0x60, 0x95, 0x8b, 0x6c, 0x37, 0x1e, 0x8d, 0x84,
-	0x97, 0xc7, 0x29, 0x7b, 0x04, 0xad, 0x23, 0xe1, 0xfa, 0x22, 0xb5, 0xb5, 0x2d, 0x6d, 0x7b, 0xe5,
-	0xfe, 0xed, 0x9d, 0xb9, 0xd3, 0xed, 0x34, 0x9d, 0x76, 0x9e, 0x90, 0x07, 0x57, 0x9e, 0xcc, 0x86,
-	0x76, 0x28, 0xb2, 0xcc, 0x1d, 0x0a, 0x5b, 0xdf, 0xd2, 0xb6, 0xbb, 0xbc, 0x14, 0xd9, 0x43, 0x68,
-	0x65, 0xb9, 0x9b, 0x17, 0x99, 0x6d, 0xd0, 0xe8, 0x6f, 0x2f, 0x18, 0xbd, 0x1a, 0x7a, 0x40, 0xd6,
-	0x5c, 0x79, 0x6d, 0xdc, 0x82, 0x96, 0x9c, 0x8b, 0x31, 0x30, 0xf3, 0x71, 0x22, 0x6c, 0x73, 0x4b,
-	0xdb, 0xb6, 0x38, 0xb5, 0x9d, 0x7f, 0x1a, 0xd0, 0xab, 0x3c, 0xf7, 0xd3, 0xd8, 0x63, 0x1b, 0xd0,
-	0x39, 0x8a, 0xb3, 0xfc, 0x99, 0x1b, 0x96, 0x4b, 0xa9, 0x64, 0xf6, 0x63, 0xe8, 0xaa, 0x49, 0x05,
-	0x2e, 0xc7, 0xd8, 0x5e, 0xb9, 0xbf, 0xb9, 0x60, 0x39, 0xfb, 0x52, 0xe2, 0xb5, 0x03, 0xbb, 0x0b,
-	0x26, 0x8e, 0x44, 0xf3, 0xaf, 0xdc, 0x7f, 0x63, 0x81, 0xe3, 0x93, 0x38, 0xcb, 0x39, 0x19, 0xb2,
-	0x1f, 0x82, 0x19, 0x44, 0x87, 0xb1, 0x6d, 0x91, 0xc3, 0x9b, 0x0b, 0x1c, 0x06, 0xe3, 0x2c, 0x17,
-	0xe1, 0x5e, 0x74, 0x18, 0x73, 0x32, 0xc7, 0xbd, 0x1c, 0xa6, 0x71, 0x91, 0xec, 0xf9, 0x76, 0x8b,
-	0x3e, 0xb5, 0x14, 0xd9, 0x2d, 0xe8, 0x52, 0x73, 0x10, 0x7c, 0x21, 0xec, 0x36, 0xf5, 0xd5, 0x0a,
-	0xb6, 0x07, 0x70, 0x5c, 0x1c, 0x88, 0x34, 0x12, 0xb9, 0xc8, 0xec, 0x0e, 0x4d, 0xfa, 0xbd, 0x6a,
-	0x52, 0x9a, 0xac, 0x8c, 0x84, 0x8f, 0x8a, 0x03, 0xf1, 0x54, 0xe4, 0x2e, 0x76, 0xee, 0x4b, 0x1d,
-	0x6f, 0x38, 0xb3, 0xf7, 0xc1, 0x10, 0x5e, 0x66, 0x77, 0x69, 0x8c, 0xed, 0xf9, 0x63, 0xfc, 0x6c,
-	0x77, 0x30, 0x3d, 0x04, 0x3a, 0xb1, 0x0f, 0x00, 0xbc, 0x38, 0xca, 0xdd, 0x20, 0x12, 0x69, 0x66,
-	0x03, 0xed, 0xf2, 0xd6, 0xc2, 0x43, 0x57, 0x86, 0xbc, 0xe1, 0xe3, 0xfc, 0x41, 0x83, 0xf5, 0xea,
-	0x50, 0x77, 0xe3, 0x28, 0x12, 0x5e, 0x1e, 0xc4, 0x51, 0xb6, 0xf4, 0x6c, 0x77, 0x61, 0xc5, 0xab,
-	0x4d, 0xd5, 0xe9, 0xbe, 0xb9, 0x78, 0x5e, 0x65, 0xc9, 0x9b, 0x5e, 0x17, 0x3e, 0x62, 0xe7, 0xdf,
-	0x3a, 0x5c, 0xab, 0x96, 0xca, 0x85, 0x3b, 0x7a, 0x1e, 0x84, 0x62, 0xe9, 0x3a, 0x1f, 0x80, 0x85,
-	0x91, 0x5d, 0xae, 0xd0, 0x59, 0x1e, 0x7f, 0x08, 0x06, 0x2e, 0x1d, 0xd8, 0x4d, 0x68, 0xe1, 0x28,
-	0x7b, 0xbe, 0x42, 0x80, 0x92, 0xd8, 0x3a, 0x58, 0x71, 0x3a, 0xdc, 0xf3, 0x29, 0xce, 0x2c, 0x2e,
-	0x85, 0x4b, 0x47, 0x91, 0x0d, 0xed, 0xa8, 0x08, 0x77, 0x93, 0x42, 0x86, 0x90, 0xc5, 0x4b, 0x91,
-	0x6d, 0xc1, 0x4a, 0x1e, 0xe7, 0xee, 0xe8, 0xa9, 0x08, 0xe3, 0x74, 0x4c, 0xc1, 0x61, 0xf0, 0xa6,
-	0x8a, 0x7d, 0x0c, 0x6b, 0xd5, 0x31, 0x0e, 0xe8, 0x23, 0xe5, 0xf1, 0xbf, 0x75, 0xd6, 0xf1, 0xd3,
-	0x67, 0x4e, 0xf9, 0x3a, 0x5f, 0x19, 0xc0, 0x9a, 0x61, 0x20, 0xfb, 0x26, 0x36, 0x57, 0x9b, 0xda,
-	0xdc, 0x12, 0x71, 0xfa, 0xc5, 0x10, 0x37, 0x19, 0xb2, 0xc6, 0xc5, 0x43, 0xb6, 0xb9, 0xdb, 0xe6,
-	0x92, 0xdd, 0xb6, 0x96, 0x63, 0xb6, 0xf5, 0x0d, 0x60, 0xb6, 0x7d, 0x19, 0xcc, 0x96, 0x71, 0xdf,
-	0x39, 0x6f, 0xdc, 0xff, 0x46, 0x87, 0x8d, 0xd9, 0xb3, 0x99, 0x0b, 0x80, 0xe9, 0x33, 0x7a, 0xbf,
-	0x04, 0x80, 0x7e, 0x81, 0xd8, 0x50, 0x10, 0x68, 0x04, 0xa7, 0xb1, 0x34, 0x38, 0xcd, 0xd9, 0xe0,
-	0xac, 0xe1, 0x63, 0x4d, 0xc0, 0xe7, 0x92, 0x40, 0x71, 0xee, 0x35, 0xa2, 0x93, 0x8b, 0x5f, 0xcb,
-	0xb4, 0xb5, 0x0c, 0xfa, 0xce, 0x00, 0xae, 0x4e, 0x65, 0x39, 0xf6, 0x16, 0xf4, 0x5c, 0x2f, 0x0f,
-	0x4e, 0xc4, 0xee, 0x28, 0x10, 0x51, 0x9e, 0xd1, 0x6e, 0x59, 0x7c, 0x52, 0x89, 0x83, 0x06, 0x51,
-	0x2e, 0xd2, 0x13, 0x77, 0x44, 0x83, 0x5a, 0xbc, 0x92, 0x9d, 0x3f, 0xb6, 0xa0, 0xad, 0xc8, 0x82,
-	0xf5, 0xc1, 0x38, 0x16, 0x63, 0x1a, 0xa3, 0xc7, 0xb1, 0x89, 0x9a, 0x24, 0xf0, 0x95, 0x13, 0x36,
-	0xab, 0xa3, 0x36, 0xce, 0x9b, 0xc5, 0x1e, 0x40, 0xdb, 0x8b, 0xc3, 0xd0, 0x8d, 0x7c, 0x45, 0x8b,
-	0x9b, 0x0b, 0x4f, 0x8c, 0xac, 0x78, 0x69, 0xce, 0xde, 0x05, 0xb3, 0xc8, 0x44, 0xaa, 0xf2, 0xdf,
-	0x19, 0x4c, 0xf7, 0x22, 0x13, 0x29, 0x27, 0x7b, 0xf6, 0x1e, 0xb4, 0x42, 0x79, 0x8c, 0xed, 0xa5,
-	0x38, 0x96, 0x07, 0x4b, 0xf1, 0xa1, 0x1c, 0xd8, 0x3d, 0x30, 0xbc, 0xa4, 0x50, 0x71, 0xbc, 0x70,
-	0xa1, 0xfb, 0x2f, 0xc8, 0x09, 0x4d, 0xd9, 0x26, 0x80, 0x97, 0x0a, 0x37, 0x17, 0x18, 0xb8, 0x8a,
-	0xd4, 0x1a, 0x1a, 0xf6, 0x10, 0xba, 0x15, 0xce, 0x6d, 0xa0, 0x71, 0xcf, 0xa6, 0x86, 0xda, 0x05,
-	0x03, 0x33, 0x4e, 0x44, 0xf4, 0xa1, 0xbf, 0x1b, 0x17, 0x51, 0x6e, 0xaf, 0xd0, 0x49, 0x34, 0x55,
-	0xec, 0x3d, 0x09, 0x08, 0x61, 0xaf, 0x6e, 0x69, 0xdb, 0x6b, 0xf7, 0xbf, 0x73, 0x76, 0x46, 0x10,
-	0x12, 0x0f, 0xc8, 0x77, 0xad, 0x20, 0x46, 0x8d, 0xdd, 0xa3, 0x95, 0x7d, 0x6b, 0x81, 0xef, 0xde,
-	0x27, 0x72, 0x97, 0xa4, 0x31, 0xae, 0xa9, 0x5a, 0xe0, 0x9e, 0x6f, 0xaf, 0x51, 0x9c, 0x36, 0x55,
-	0xcc, 0x81, 0xd5, 0x4a, 0xfc, 0x48, 0x8c, 0xed, 0xab, 0x14, 0x52, 0x13, 0x3a, 0x76, 0x1f, 0xd6,
-	0x4f, 0xe2, 0x51, 0x11, 0xe5, 0x6e, 0x3a, 0xde, 0xcd, 0x5f, 0x0e, 0x4e, 0x83, 0xdc, 0x3b, 0x12,
-	0x99, 0xdd, 0xdf, 0xd2, 0xb6, 0x4d, 0x3e, 0xb7, 0x8f, 0xbd, 0x0b, 0x37, 0x83, 0x68, 0xae, 0xd7,
-	0x35, 0xf2, 0x5a, 0xd0, 0x8b, 0x20, 0x3d, 0x18, 0xe7, 0x02, 0x97, 0xc2, 0xb6, 0xb4, 0xed, 0x55,
-	0x5e, 0x8a, 0xec, 0x36, 0xf4, 0xab, 0x55, 0x3d, 0x52, 0x26, 0xd7, 0xc9, 0x64, 0x46, 0xef, 0x7c,
-	0xa5, 0x41, 0x5b, 0x45, 0x29, 0x56, 0x93, 0x6e, 0x3a, 0x44, 0xc0, 0x19, 0xdb, 0x5d, 0x4e, 0x6d,
-	0x44, 0x8b, 0x77, 0xea, 0x13, 0x34, 0xba, 0x1c, 0x9b, 0x68, 0x95, 0xc6, 0xb1, 0x2c, 0x08, 0xba,
-	0x9c, 0xda, 0x48, 0x24, 0x71, 0xf4, 0x38, 0xc8, 0x8e, 0x29, 0xb0, 0x3b, 0x5c, 0x49, 0x68, 0x9b,
-	0x20, 0xd8, 0x24, 0x8b, 0x50, 0x1b, 0x6d, 0x13, 0xa2, 0x0c, 0xc5, 0x1f, 0x4a, 0xc2, 0x99, 0xc4,
-	0x4b, 0x41, 0x71, 0xda, 0xe5, 0xd8, 0x74, 0x7e, 0xab, 0xc1, 0x4a, 0x03, 0x0a, 0x38, 0x5a, 0x54,
-	0xd3, 0x27, 0xb5, 0xd1, 0xab, 0xa8, 0xd1, 0x5c, 0x04, 0x3e, 0x6a, 0x86, 0x81, 0xaf, 0xc8, 0x10,
-	0x9b, 0xe8, 0x27, 0xd0, 0x48, 0x55, 0xc9, 0xd8, 0x26, 0x1d, 0x9a, 0x59, 0x4a, 0xa7, 0xec, 0xb2,
-	0xa2, 0x5e, 0x6d, 0xa6, 0xec, 0x32, 0xb4, 0x6b, 0x2b, 0xdd, 0x30, 0xf0, 0x9d, 0xaf, 0x2d, 0xe8,
-	0xd6, 0xc9, 0xb7, 0xac, 0xc1, 0xd5, 0xaa, 0xb0, 0xcd, 0xd6, 0x40, 0x57, 0x8b, 0xea, 0x72, 0x5d,
-	0x8e, 0x42, 0x2b, 0x37, 0x1a, 0x2b, 0x5f, 0x07, 0x2b, 0x08, 0xf1, 0x76, 0x20, 0x37, 0x52, 0x0a,
-	0xc8, 0x6b, 0x5e, 0x52, 0x7c, 0x1c, 0x84, 0x41, 0x4e, 0x6b, 0xd3, 0x79, 0x25, 0x63, 0x8c, 0x4a,
-	0x4c, 0xcb, 0xee, 0x16, 0x85, 0x47, 0x53, 0xc5, 0x7e, 0x54, 0xe2, 0xa6, 0x43, 0xb8, 0xf9, 0xee,
-	0x79, 0x12, 0x49, 0x85, 0x9c, 0x87, 0x74, 0xe9, 0x19, 0xe5, 0x47, 0x04, 0xf9, 0xb5, 0x25, 0xd7,
-	0x12, 0xe5, 0xfd, 0x84, 0xac, 0xb9, 0xf2, 0xc2, 0x80, 0x94, 0x24, 0xe1, 0x13, 0x29, 0x18, 0xbc,
-	0x14, 0x29, 0x64, 0x0e, 0x92, 0x8c, 0x90, 0xae, 0x73, 0x6a, 0xa3, 0xee, 0x14, 0x75, 0xab, 0x52,
-	0x87, 0xed, 0x92, 0xac, 0x7b, 0x35, 0x59, 0xdf, 0x82, 0x6e, 0x24, 0x72, 0xee, 0x9d, 0xf8, 0xfb,
-	0x19, 0x81, 0x52, 0xe7, 0xb5, 0x42, 0xf5, 0x0e, 0x44, 0x94, 0xef, 0x67, 0x84, 0x47, 0xd9, 0x2b,
-	0x15, 0x48, 0x63, 0xca, 0xf4, 0x51, 0x22, 0x21, 0xa8, 0xf3, 0x86, 0x46, 0xf5, 0xa3, 0x31, 0xf6,
-	0x5f, 0xab, 0xfa, 0x95, 0x06, 0xbf, 0x07, 0xb9, 0x77, 0xdf, 0xcb, 0x09, 0x60, 0x3a, 0x2f, 0x45,
-	0x9c, 0x37, 0xa3, 0x82, 0x09, 0xfb, 0xae, 0xcb, 0x79, 0x2b, 0x05, 0x1e, 0x21, 0x25, 0x59, 0xec,
-	0x5c, 0x97, 0x47, 0x58, 0xca, 0x18, 0xfc, 0xa1, 0x08, 0x79, 0x96, 0xd9, 0x37, 0xe8, 0xf4, 0x94,
-	0x84, 0x3e, 0xa1, 0x08, 0x77, 0x5d, 0xef, 0x48, 0xd8, 0x37, 0xa9, 0xa7, 0x92, 0xab, 0xf4, 0xf4,
-	0xda, 0x79, 0xd3, 0x93, 0x0d, 0xed, 0x2c, 0x77, 0x53, 0x3c, 0x08, 0x5b, 0x1e, 0x84, 0x12, 0x9b,
-	0x9c, 0xf1, 0xfa, 0x24, 0x67, 0x60, 0x14, 0xbb, 0xc3, 0xcc, 0xde, 0x90, 0xd8, 0xc7, 0xb6, 0xf3,
-	0xe7, 0x4e, 0x85, 0x3f, 0xe2, 0x48, 0x95, 0x39, 0xb5, 0x3a, 0x73, 0x4e, 0x66, 0x0a, 0x7d, 0x26,
-	0x53, 0xd4, 0x69, 0xcb, 0xb8, 0x64, 0xda, 0x32, 0xcf, 0x9f, 0xb6, 0x10, 0x64, 0x81, 0x57, 0x56,
-	0x94, 0xd4, 0xc6, 0x0f, 0xce, 0x8f, 0x52, 0xe1, 0xfa, 0x99, 0x42, 0x70, 0x29, 0x4e, 0x27, 0xa1,
-	0xce, 0x6c, 0x12, 0x52, 0xd1, 0xd8, 0xad, 0xa3, 0x71, 0x2a, 0x49, 0xc0, 0x6c, 0x92, 0x78, 0x3a,
-	0x55, 0xee, 0x0b, 0x8a, 0xf9, 0x73, 0x23, 0x71, 0xca, 0x99, 0xfd, 0x1c, 0x56, 0x93, 0x46, 0x8e,
-	0xbb, 0x48, 0x3a, 0x9c, 0x70, 0x64, 0xfb, 0x70, 0xd5, 0x9b, 0x84, 0x2d, 0xe1, 0xe5, 0xfc, 0x20,
-	0x9f, 0x76, 0xc7, 0x32, 0xad, 0x52, 0xf1, 0x83, 0x0a, 0x60, 0x93, 0xca, 0x09, 0xab, 0x4f, 0x0f,
-	0x2a, 0x98, 0x4d, 0x2a, 0x67, 0x52, 0x2b, 0x9b, 0x93, 0x5a, 0xeb, 0xbc, 0x7e, 0xfd, 0x22, 0x79,
-	0x7d, 0x07, 0x58, 0x35, 0xcc, 0xb3, 0x8a, 0x49, 0x24, 0x2c, 0xe7, 0xf4, 0x4c, 0xdb, 0x2b, 0x6e,
-	0xb9, 0x31, 0x6b, 0xaf, 0x48, 0xe6, 0x1e, 0x5c, 0x9f, 0x1e, 0x05, 0xd9, 0xe4, 0x26, 0x39, 0xcc,
-	0xeb, 0x9a, 0xf6, 0x28, 0xf9, 0xe7, 0xb5, 0x59, 0x8f, 0x92, 0x88, 0x16, 0x55, 0x15, 0xf6, 0xa5,
-	0xaa, 0x8a, 0xd7, 0xcf, 0x5b, 0x55, 0x6c, 0x9c, 0x5d, 0x55, 0xbc, 0xb1, 0xa0, 0xaa, 0xf8, 0x8b,
-	0x09, 0xbd, 0x89, 0xd0, 0x56, 0x19, 0x51, 0xab, 0x32, 0x62, 0x83, 0x5c, 0xf5, 0x25, 0xe4, 0x6a,
-	0x2c, 0x23, 0x57, 0x73, 0x8a, 0x5c, 0x97, 0xe5, 0xce, 0x9a, 0x78, 0x5b, 0x0b, 0x89, 0xb7, 0x3d,
-	0x45, 0xbc, 0xb2, 0x4f, 0x8e, 0xd7, 0xa9, 0xfa, 0xe4, 0x78, 0x65, 0x4a, 0xeb, 0xce, 0x49, 0x69,
-	0xd0, 0x48, 0x69, 0x13, 0x09, 0x6c, 0x65, 0x69, 0x02, 0x5b, 0x5d, 0x9e, 0xc0, 0x7a, 0x67, 0x24,
-	0xb0, 0xb5, 0x99, 0x04, 0x56, 0x55, 0x03, 0x57, 0xff, 0xaf, 0x6a, 0xa0, 0x7f, 0xa9, 0x6a, 0x40,
-	0xb1, 0xe7, 0xb5, 0x9a, 0x3d, 0x1b, 0x69, 0x89, 0x2d, 0x4c, 0x4b, 0xd7, 0x27, 0x82, 0xce, 0xf9,
-	0xbd, 0x06, 0x50, 0xbf, 0x4d, 0xe0, 0x0e, 0x17, 0x45, 0x15, 0x47, 0xd4, 0x66, 0x77, 0x40, 0x8f,
-	0x33, 0xf5, 0xbc, 0xb1, 0x88, 0x14, 0x3e, 0x19, 0xd0, 0xd3, 0x86, 0x1e, 0x23, 0x98, 0x4c, 0x4f,
-	0x5e, 0x96, 0x8d, 0xe5, 0x89, 0x45, 0x3e, 0x86, 0x78, 0x73, 0x6e, 0xd2, 0xd6, 0xcc, 0x4d, 0xda,
-	0xf9, 0x52, 0x83, 0x96, 0x9c, 0x64, 0x6e, 0x95, 0xba, 0x01, 0x9d, 0x64, 0xe4, 0xe6, 0x87, 0x71,
-	0x1a, 0x96, 0x57, 0xe0, 0x52, 0xc6, 0xc8, 0x3c, 0x74, 0xc3, 0x60, 0x34, 0x56, 0xd5, 0xa1, 0x92,
-	0x70, 0x53, 0x4e, 0x44, 0x9a, 0x05, 0x71, 0xa4, 0x2a, 0xc4, 0x52, 0x44, 0x52, 0x3d, 0x16, 0x69,
-	0x24, 0x46, 0xbf, 0x50, 0xfd, 0x16, 0xf5, 0x4f, 0x2a, 0x69, 0x49, 0x92, 0x0c, 0x71, 0x7a, 0x4c,
-	0x7a, 0x1c, 0x63, 0x41, 0x93, 0xc0, 0x28, 0x65, 0x0c, 0xc1, 0xd3, 0x34, 0xc8, 0x05, 0x75, 0x4a,
-	0x28, 0xd6, 0x0a, 0x9c, 0x0a, 0x2d, 0x11, 0xd7, 0x19, 0x59, 0x48, 0x40, 0x4e, 0x2a, 0xd9, 0xdb,
-	0xb0, 0x46, 0x2e, 0xb5, 0x99, 0x84, 0xe6, 0x94, 0xd6, 0xf9, 0x97, 0x06, 0x50, 0xbf, 0x33, 0xce,
-	0xa9, 0x27, 0xd6, 0x40, 0x3f, 0x2c, 0x8b, 0x79, 0xfd, 0xd0, 0x9f, 0xda, 0x1b, 0xab, 0xda, 0x9b,
-	0x39, 0xef, 0xde, 0xec, 0xfb, 0x60, 0x8d, 0x5c, 0xdf, 0x2f, 0xef, 0xd6, 0x8b, 0xea, 0xa4, 0x9f,
-	0xfa, 0x7e, 0xca, 0xa5, 0x25, 0xba, 0xa4, 0xe4, 0xd2, 0x3a, 0x87, 0x0b, 0x59, 0xe2, 0x8a, 0xd4,
-	0xdb, 0x7d, 0x5b, 0x9e, 0x96, 0x94, 0x9c, 0x5f, 0x81, 0x89, 0x66, 0x55, 0xb1, 0xa6, 0x9d, 0xb7,
-	0x58, 0x43, 0x62, 0x4c, 0xaa, 0xab, 0x42, 0x42, 0x57, 0xa6, 0x38, 0xcd, 0xd5, 0x07, 0x53, 0xdb,
-	0xf9, 0x93, 0x06, 0x50, 0x97, 0x48, 0xb8, 0x6f, 0x69, 0x26, 0xdf, 0x45, 0x4c, 0x8e, 0x4d, 0xd4,
-	0x9c, 0x84, 0x12, 0x04, 0x26, 0xc7, 0x26, 0xdd, 0x5b, 0x4e, 0xdd, 0x84, 0x86, 0x31, 0x39, 0xb5,
-	0x69, 0xed, 0x47, 0x6e, 0x2a, 0xe4, 0x4d, 0xc8, 0xe4, 0x4a, 0xa2, 0xdd, 0x14, 0x2f, 0x25, 0x67,
-	0x9a, 0x9c, 0xda, 0x38, 0xe2, 0x28, 0x38, 0x50, 0x64, 0x89, 0x4d, 0xb4, 0xc2, 0x8f, 0x51, 0x2c,
-	0x49, 0x6d, 0xbc, 0xc3, 0xf8, 0x41, 0x9a, 0x8f, 0x15, 0x3d, 0x4a, 0xc1, 0xf9, 0x9d, 0x0e, 0x6d,
-	0x55, 0x99, 0x61, 0x14, 0x8f, 0xdc, 0x2c, 0xdf, 0x4d, 0x0a, 0x05, 0x88, 0x52, 0x9c, 0x60, 0x72,
-	0x7d, 0x8a, 0xc9, 0x1b, 0xd9, 0xc1, 0x58, 0x92, 0x1d, 0xcc, 0xe9, 0xec, 0x80, 0x8c, 0x58, 0x84,
-	0xcf, 0x55, 0xc5, 0x27, 0x0b, 0xc1, 0x86, 0x86, 0x3d, 0x50, 0xe0, 0x6f, 0x2d, 0x7d, 0x67, 0x1b,
-	0x04, 0xd1, 0x70, 0x24, 0xca, 0xda, 0x52, 0x52, 0x40, 0x59, 0x5c, 0xb6, 0x1b, 0xc5, 0xe5, 0x06,
-	0x74, 0x70, 0x59, 0x54, 0xfb, 0x76, 0x88, 0x13, 0x2a, 0x19, 0x57, 0x22, 0x97, 0xd5, 0x7c, 0x43,
-	0xa9, 0x35, 0xce, 0x4f, 0xa0, 0x37, 0x31, 0xcd, 0x22, 0xda, 0x58, 0xb4, 0x45, 0xce, 0xd7, 0x1a,
-	0x6d, 0x32, 0x51, 0xce, 0x4d, 0x68, 0x45, 0x45, 0x78, 0xa0, 0xfe, 0xae, 0xb2, 0xb8, 0x92, 0x50,
-	0x7f, 0x22, 0x22, 0x3f, 0x4e, 0x55, 0x7c, 0x29, 0x69, 0x21, 0xe5, 0xac, 0x83, 0x15, 0xc6, 0xbe,
-	0x18, 0x95, 0x57, 0x52, 0x12, 0xf0, 0x53, 0x92, 0xa3, 0x71, 0x16, 0x78, 0xee, 0x48, 0xbd, 0x14,
-	0x76, 0x79, 0x43, 0x83, 0xa3, 0x79, 0x71, 0x2a, 0xd4, 0x63, 0x61, 0x97, 0x2b, 0x09, 0x47, 0xc3,
-	0x56, 0x59, 0x79, 0x4b, 0x01, 0x03, 0x2b, 0x3c, 0xfa, 0x42, 0xed, 0x17, 0x36, 0xf1, 0x48, 0x3d,
-	0xcc, 0xb7, 0xf4, 0xa6, 0xd8, 0x95, 0x6f, 0x8a, 0x95, 0xc2, 0xf9, 0xbb, 0x06, 0xe6, 0x93, 0x12,
-	0x28, 0x25, 0x59, 0x60, 0x05, 0x51, 0xbd, 0xf1, 0xeb, 0xcd, 0x37, 0xfe, 0x79, 0x37, 0xed, 0x77,
-	0xd4, 0xdd, 0xc6, 0xa4, 0x53, 0xff, 0xf6, 0x12, 0x4c, 0x3e, 0x77, 0x87, 0x99, 0xbc, 0xfc, 0x60,
-	0x08, 0xba, 0xa3, 0x11, 0x2a, 0x28, 0x5a, 0xba, 0xbc, 0x14, 0x9b, 0x2f, 0xae, 0xed, 0xa5, 0x2f,
-	0xae, 0x9d, 0xd9, 0x3c, 0xf1, 0x10, 0x3a, 0xe5, 0x3c, 0x14, 0x22, 0x71, 0x91, 0x7a, 0xe2, 0x79,
-	0xf9, 0x7c, 0xd0, 0xe3, 0x0d, 0x4d, 0x75, 0x25, 0xd3, 0xeb, 0x2b, 0xd9, 0xed, 0x00, 0xd6, 0x26,
-	0xd3, 0x35, 0x5b, 0x81, 0x76, 0x11, 0x1d, 0x47, 0xf1, 0x69, 0xd4, 0xbf, 0x82, 0x82, 0xba, 0x73,
-	0xf7, 0x35, 0xb6, 0x06, 0x90, 0x0a, 0x4a, 0xb1, 0x41, 0x34, 0xec, 0xeb, 0xd8, 0x99, 0x16, 0x51,
-	0x84, 0x82, 0xc1, 0x00, 0x5a, 0x89, 0x5b, 0x64, 0xc2, 0xef, 0x9b, 0xd8, 0x16, 0x2f, 0x03, 0x74,
-	0xb2, 0x58, 0x07, 0x4c, 0x5f, 0xb8, 0x7e, 0xbf, 0x75, 0xfb, 0x19, 0x5c, 0x9d, 0xca, 0xed, 0xec,
-	0x1a, 0xf4, 0xd4, 0x5c, 0x52, 0xd1, 0xbf, 0xc2, 0x56, 0xa1, 0x53, 0x4d, 0xa1, 0xe1, 0x14, 0x32,
-	0xfd, 0x8f, 0xfb, 0x3a, 0xeb, 0x41, 0xb7, 0x88, 0x4a, 0xd1, 0xb8, 0xfd, 0x21, 0xac, 0x36, 0x2f,
-	0x28, 0xcc, 0x02, 0xed, 0x45, 0xff, 0x0a, 0xfe, 0x3c, 0xee, 0x6b, 0xf8, 0xc3, 0xfb, 0x3a, 0xfe,
-	0x0c, 0xfa, 0x06, 0xfe, 0x3c, 0xef, 0x9b, 0xf8, 0xf3, 0x69, 0xdf, 0xc2, 0x9f, 0x5f, 0xf6, 0x5b,
-	0xf8, 0xf3, 0x59, 0xbf, 0xfd, 0xe8, 0x83, 0xbf, 0xbe, 0xda, 0xd4, 0xfe, 0xf1, 0x6a, 0x53, 0xfb,
-	0xcf, 0xab, 0x4d, 0xed, 0xcb, 0xff, 0x6e, 0x5e, 0xf9, 0x6c, 0x67, 0xce, 0x9f, 0xbe, 0xea, 0x8c,
-	0xef, 0xa8, 0x33, 0xbe, 0x43, 0x67, 0x7c, 0x97, 0x02, 0xfa, 0xa0, 0x45, 0xff, 0xfa, 0xbe, 0xf3,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x63, 0x77, 0xb6, 0x51, 0x1e, 0x00, 0x00,
+	// 3382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xf1, 0xd7, 0xcc, 0xce, 0xbe, 0x9a, 0xaf, 0x55, 0x8b, 0x96, 0xc7, 0x94, 0x4c, 0x53, 0x6b, 0x4b,
+	0x7f, 0xfe, 0x05, 0x8b, 0xb2, 0xe5, 0xd8, 0x90, 0x9c, 0x40, 0xb1, 0x48, 0xd9, 0x11, 0x61, 0xcb,
+	0x62, 0x7a, 0xa5, 0x38, 0x70, 0x0e, 0xc6, 0xec, 0x4c, 0x73, 0x39, 0xe0, 0xbc, 0x3c, 0xd3, 0x43,
+	0x6a, 0x7d, 0xca, 0xd1, 0x47, 0x5f, 0x72, 0x30, 0x90, 0x4b, 0x6e, 0x09, 0x12, 0xe4, 0x9a, 0x73,
+	0x2e, 0x46, 0x90, 0x5c, 0x92, 0x43, 0x80, 0x1c, 0x03, 0x07, 0xf9, 0x06, 0xf9, 0x00, 0x41, 0x55,
+	0x77, 0xcf, 0xcc, 0x3e, 0xf9, 0x88, 0x4f, 0xdb, 0x55, 0xd5, 0xd5, 0xcf, 0x7a, 0xfc, 0xba, 0x66,
+	0xc9, 0x82, 0x33, 0xe0, 0x91, 0xd8, 0x4a, 0xd2, 0x58, 0xc4, 0xf4, 0x05, 0xcf, 0x11, 0x8e, 0x17,
+	0x0f, 0x80, 0x74, 0x79, 0x96, 0x7d, 0x86, 0xc2, 0xb5, 0xef, 0x0d, 0x7c, 0x71, 0x90, 0xf7, 0xb7,
+	0xdc, 0x38, 0xbc, 0xfd, 0xd0, 0x11, 0xce, 0xc3, 0x78, 0x70, 0x1b, 0x25, 0xb7, 0x12, 0x67, 0x18,
+	0xc4, 0x8e, 0x27, 0xa9, 0xcf, 0x14, 0x25, 0x07, 0xeb, 0xfe, 0xd9, 0x20, 0x8b, 0x8c, 0x67, 0x3b,
+	0x71, 0x10, 0x70, 0x57, 0xc4, 0x29, 0xdd, 0x26, 0x8d, 0x03, 0xee, 0x78, 0x3c, 0xb5, 0x8d, 0x0d,
+	0x63, 0x73, 0xe1, 0xce, 0xcd, 0xad, 0xa9, 0xd3, 0x6d, 0x55, 0x95, 0xb6, 0x1e, 0xa1, 0x06, 0x53,
+	0x9a, 0xd4, 0x26, 0xcd, 0x90, 0x67, 0x99, 0x33, 0xe0, 0xb6, 0xb9, 0x61, 0x6c, 0xb6, 0x99, 0x26,
+	0xe9, 0x7d, 0xd2, 0xc8, 0x84, 0x23, 0xf2, 0xcc, 0xae, 0xe1, 0xe8, 0x37, 0x66, 0x8c, 0x5e, 0x0c,
+	0xdd, 0xc3, 0xde, 0x4c, 0x69, 0xad, 0x5d, 0x25, 0x0d, 0x39, 0x17, 0xa5, 0xc4, 0x12, 0xc3, 0x84,
+	0xdb, 0xd6, 0x86, 0xb1, 0x59, 0x67, 0xd8, 0xee, 0xfe, 0xd6, 0x22, 0x4b, 0x85, 0xe6, 0x5e, 0x1a,
+	0xbb, 0x74, 0x8d, 0xb4, 0x0e, 0xe2, 0x4c, 0x7c, 0xec, 0x84, 0x7a, 0x29, 0x05, 0x4d, 0x7f, 0x40,
+	0xda, 0x6a, 0x52, 0x0e, 0xcb, 0xa9, 0x6d, 0x2e, 0xdc, 0x59, 0x9f, 0xb1, 0x9c, 0x3d, 0x49, 0xb1,
+	0x52, 0x81, 0xde, 0x26, 0x16, 0x8c, 0x84, 0xf3, 0x2f, 0xdc, 0xb9, 0x32, 0x43, 0xf1, 0x51, 0x9c,
+	0x09, 0x86, 0x1d, 0xe9, 0xdb, 0xc4, 0xf2, 0xa3, 0xfd, 0xd8, 0xae, 0xa3, 0xc2, 0xb5, 0x19, 0x0a,
+	0xbd, 0x61, 0x26, 0x78, 0xb8, 0x1b, 0xed, 0xc7, 0x0c, 0xbb, 0xc3, 0x59, 0x0e, 0xd2, 0x38, 0x4f,
+	0x76, 0x3d, 0xbb, 0x81, 0x5b, 0xd5, 0x24, 0xbd, 0x4a, 0xda, 0xd8, 0xec, 0xf9, 0x5f, 0x70, 0xbb,
+	0x89, 0xb2, 0x92, 0x41, 0x77, 0x09, 0x39, 0xcc, 0xfb, 0x3c, 0x8d, 0xb8, 0xe0, 0x99, 0xdd, 0xc2,
+	0x49, 0xff, 0xbf, 0x98, 0x14, 0x27, 0xd3, 0x96, 0xf0, 0x61, 0xde, 0xe7, 0x8f, 0xb9, 0x70, 0x40,
+	0xb8, 0x27, 0x79, 0xac, 0xa2, 0x4c, 0xdf, 0x25, 0x35, 0xee, 0x66, 0x76, 0x1b, 0xc7, 0xd8, 0x9c,
+	0x3e, 0xc6, 0xfb, 0x3b, 0xbd, 0xf1, 0x21, 0x40, 0x89, 0xbe, 0x47, 0x88, 0x1b, 0x47, 0xc2, 0xf1,
+	0x23, 0x9e, 0x66, 0x36, 0xc1, 0x53, 0xde, 0x98, 0x79, 0xe9, 0xaa, 0x23, 0xab, 0xe8, 0xd0, 0x07,
+	0xa4, 0x19, 0x87, 0xbe, 0x10, 0xdc, 0xb3, 0x17, 0x70, 0x05, 0xff, 0x37, 0x43, 0xfd, 0x89, 0xec,
+	0xb5, 0xa7, 0xaf, 0x88, 0x69, 0x3d, 0xba, 0x4a, 0xea, 0x1e, 0x0f, 0x84, 0x63, 0x2f, 0x6e, 0x18,
+	0x9b, 0x2d, 0x26, 0x89, 0xee, 0xaf, 0x0d, 0xb2, 0x5a, 0x58, 0xcb, 0x4e, 0x1c, 0x45, 0xdc, 0x15,
+	0x7e, 0x1c, 0x65, 0x73, 0x8d, 0x66, 0x87, 0x2c, 0xb8, 0x65, 0x57, 0x65, 0x36, 0xd7, 0x66, 0x6f,
+	0x48, 0xf5, 0x64, 0x55, 0xad, 0x33, 0xdb, 0x4e, 0xf7, 0x97, 0x35, 0x72, 0xb1, 0x58, 0x2a, 0xe3,
+	0x4e, 0xf0, 0xd4, 0x0f, 0xf9, 0xdc, 0x75, 0xde, 0x25, 0x75, 0x70, 0x19, 0xbd, 0xc2, 0xee, 0x7c,
+	0xc3, 0x06, 0x2f, 0x63, 0x52, 0x81, 0x5e, 0x26, 0x0d, 0x18, 0x65, 0xd7, 0x53, 0xae, 0xa5, 0x28,
+	0x38, 0xc4, 0x38, 0x1d, 0xec, 0x7a, 0x68, 0xc0, 0x75, 0x26, 0x89, 0x73, 0x9b, 0xa7, 0x4d, 0x9a,
+	0x51, 0x1e, 0xee, 0x24, 0xb9, 0xb4, 0xcd, 0x3a, 0xd3, 0x24, 0xdd, 0x20, 0x0b, 0x22, 0x16, 0x4e,
+	0xf0, 0x98, 0x87, 0x71, 0x3a, 0x44, 0xab, 0xab, 0xb1, 0x2a, 0x8b, 0x7e, 0x44, 0x96, 0x0b, 0xfb,
+	0xe8, 0xe1, 0x26, 0xa5, 0x5d, 0xbd, 0x76, 0x92, 0x5d, 0xe1, 0x36, 0xc7, 0x74, 0xbf, 0x03, 0xfb,
+	0xea, 0xfe, 0xc7, 0x20, 0x9d, 0x71, 0x29, 0x9c, 0x97, 0x1b, 0xe7, 0x91, 0xc0, 0x38, 0x5a, 0x67,
+	0x92, 0x28, 0x76, 0xb7, 0x93, 0xe4, 0x7b, 0xae, 0xc0, 0x6b, 0x33, 0x59, 0x95, 0x05, 0xb7, 0x8a,
+	0x24, 0xcb, 0x64, 0x90, 0xb4, 0x58, 0x41, 0xd3, 0x2d, 0x42, 0x65, 0x9b, 0x3b, 0xde, 0xf6, 0x50,
+	0xf0, 0x8c, 0x39, 0x42, 0x86, 0x40, 0x93, 0x4d, 0x91, 0xd0, 0x37, 0xc8, 0x25, 0xe4, 0x7e, 0x92,
+	0xfa, 0x82, 0x97, 0x0a, 0x75, 0x54, 0x98, 0x26, 0xa2, 0x37, 0x49, 0x07, 0xd9, 0x4f, 0x12, 0x1e,
+	0x7d, 0xe0, 0xed, 0xe0, 0x06, 0x1a, 0x78, 0x05, 0x13, 0xfc, 0xee, 0xd7, 0x35, 0x42, 0xab, 0x0e,
+	0x24, 0x4f, 0x75, 0xc4, 0x2c, 0x8d, 0x31, 0xb3, 0xd4, 0x41, 0xd0, 0x3c, 0x5b, 0x10, 0x1c, 0x8d,
+	0x22, 0xb5, 0x73, 0x44, 0x91, 0x8a, 0x9d, 0x5a, 0x73, 0xec, 0xb4, 0x3e, 0x3f, 0x8c, 0x36, 0xbe,
+	0x83, 0x30, 0xda, 0x3c, 0x4f, 0x18, 0xd5, 0x11, 0xa3, 0x75, 0xda, 0x88, 0xf1, 0x73, 0x93, 0xac,
+	0x4d, 0xde, 0xcd, 0xd4, 0xd0, 0x31, 0x7e, 0x47, 0xef, 0xea, 0xd0, 0x61, 0x9e, 0xc1, 0xab, 0xa4,
+	0x4a, 0xd5, 0xad, 0x6b, 0x73, 0xdd, 0xda, 0x9a, 0x74, 0xeb, 0x32, 0xf0, 0xd4, 0x47, 0x02, 0xcf,
+	0x39, 0x43, 0x4c, 0xf7, 0xef, 0xd5, 0xf8, 0x2e, 0x4d, 0x4a, 0x7a, 0xfc, 0xbc, 0xcd, 0xdf, 0x2d,
+	0x37, 0x6f, 0xcc, 0x89, 0x9b, 0x95, 0xe1, 0x2a, 0x5b, 0xd7, 0xcb, 0xac, 0xcd, 0x59, 0xa6, 0x35,
+	0x6e, 0x61, 0xfa, 0x6a, 0xeb, 0xa7, 0xbd, 0xda, 0x37, 0x2a, 0x5e, 0xc7, 0xf8, 0xe7, 0x12, 0x21,
+	0xcd, 0x4b, 0x06, 0xdd, 0xdf, 0x1b, 0x64, 0x65, 0x0c, 0x51, 0xd1, 0xd7, 0xc8, 0x92, 0xe3, 0x0a,
+	0xff, 0x88, 0xef, 0x04, 0x3e, 0x8f, 0x44, 0xa6, 0xc2, 0xd4, 0x28, 0x13, 0x46, 0xf5, 0x23, 0xc1,
+	0xd3, 0x23, 0x27, 0xc0, 0x51, 0xeb, 0xac, 0xa0, 0xe9, 0x03, 0xd2, 0xca, 0xb8, 0x10, 0x7e, 0x34,
+	0xd0, 0x68, 0xee, 0xfa, 0x4c, 0xac, 0x18, 0xc6, 0x82, 0xf7, 0x54, 0x67, 0x56, 0xa8, 0xc1, 0x95,
+	0xa7, 0x3c, 0x1b, 0x46, 0x2e, 0x1e, 0x4b, 0x8b, 0x29, 0xaa, 0xfb, 0x65, 0x8d, 0x2c, 0x8f, 0x2a,
+	0x51, 0x87, 0x2c, 0xbb, 0x07, 0xdc, 0x3d, 0xdc, 0x55, 0xd3, 0xc3, 0x82, 0xc1, 0x3c, 0xef, 0x9d,
+	0x6a, 0xce, 0xad, 0x9d, 0x11, 0xdd, 0xf7, 0x23, 0x91, 0x0e, 0xd9, 0xd8, 0x80, 0x74, 0x97, 0x34,
+	0x90, 0xa3, 0x2d, 0xff, 0xcd, 0x33, 0x0c, 0xad, 0x86, 0x54, 0x03, 0xc0, 0x95, 0x83, 0xce, 0x47,
+	0x7e, 0xe8, 0x0b, 0x65, 0x0e, 0x25, 0x83, 0xbe, 0x4e, 0x2e, 0xf6, 0x03, 0xc7, 0x3d, 0x0c, 0xfc,
+	0x4c, 0xec, 0x39, 0x42, 0xf0, 0x34, 0xca, 0x6c, 0x6b, 0xa3, 0xb6, 0xd9, 0x66, 0x93, 0x82, 0xb5,
+	0x07, 0xe4, 0xd2, 0x94, 0xd5, 0xd3, 0x0e, 0xa9, 0x1d, 0xf2, 0xa1, 0x32, 0x60, 0x68, 0x42, 0xc6,
+	0x39, 0x72, 0x82, 0x9c, 0xab, 0x9b, 0x92, 0xc4, 0xbb, 0xe6, 0x5d, 0x63, 0xed, 0x1e, 0x59, 0xa8,
+	0xac, 0xf2, 0x24, 0xd5, 0x56, 0x45, 0xb5, 0xfb, 0x9b, 0x06, 0x69, 0xaa, 0xa4, 0x56, 0xd5, 0x5b,
+	0x92, 0x7a, 0x1d, 0x52, 0x4b, 0x7c, 0x4f, 0x4d, 0x08, 0xcd, 0xc2, 0x9c, 0x6b, 0xa7, 0xc5, 0xc5,
+	0x77, 0x49, 0xd3, 0x8d, 0xc3, 0xd0, 0x89, 0x3c, 0x85, 0x87, 0xd6, 0x67, 0x06, 0x1c, 0xec, 0xc5,
+	0x74, 0x77, 0xfa, 0x0e, 0xb1, 0xf2, 0x8c, 0xa7, 0x76, 0x7d, 0xae, 0xab, 0xaa, 0xc5, 0x3f, 0xcb,
+	0x78, 0xca, 0xb0, 0x3f, 0xbd, 0x47, 0x1a, 0xa1, 0x8c, 0x42, 0xcd, 0xb9, 0x69, 0x48, 0xc6, 0x25,
+	0x0c, 0x6f, 0x4a, 0x81, 0xbe, 0x41, 0x6a, 0x6e, 0x92, 0xdb, 0xad, 0xf9, 0x0b, 0xdd, 0x7b, 0x86,
+	0x4a, 0xd0, 0x95, 0xae, 0x13, 0xe2, 0xa6, 0xdc, 0x11, 0x1c, 0xe2, 0xae, 0x42, 0x33, 0x15, 0x0e,
+	0xbd, 0x4f, 0xda, 0x45, 0x9a, 0xb2, 0xc9, 0x86, 0x71, 0xaa, 0xcc, 0x56, 0xaa, 0x40, 0x5c, 0x8d,
+	0x2b, 0xb9, 0x7a, 0x01, 0x6f, 0xa2, 0xca, 0xa2, 0xf7, 0x64, 0x48, 0xe3, 0x88, 0x7e, 0x97, 0xef,
+	0xbc, 0x7a, 0x32, 0x14, 0xe4, 0x32, 0xa6, 0x41, 0xba, 0x6e, 0xf8, 0x31, 0x70, 0xec, 0x25, 0x5c,
+	0xd9, 0xcb, 0x33, 0x74, 0x77, 0x9f, 0xc8, 0x53, 0x92, 0x9d, 0x61, 0x4d, 0xc5, 0x02, 0x77, 0x3d,
+	0x7b, 0x19, 0xed, 0xac, 0xca, 0xa2, 0x5d, 0xb2, 0x58, 0x90, 0x1f, 0xf2, 0xa1, 0xbd, 0x82, 0x26,
+	0x35, 0xc2, 0xa3, 0x77, 0xc8, 0xea, 0x51, 0x1c, 0xe4, 0x91, 0x70, 0xd2, 0xe1, 0x8e, 0x78, 0xde,
+	0x3b, 0xf6, 0x85, 0x7b, 0xc0, 0x33, 0xbb, 0x83, 0xa0, 0x68, 0xaa, 0x8c, 0xbe, 0x43, 0x2e, 0xfb,
+	0xd1, 0x54, 0xad, 0x8b, 0xa8, 0x35, 0x43, 0x0a, 0xc1, 0xbb, 0x3f, 0x14, 0x1c, 0x96, 0x42, 0x37,
+	0x8c, 0xcd, 0x45, 0xa6, 0x49, 0x00, 0x44, 0xc5, 0xaa, 0xb6, 0x55, 0x97, 0x4b, 0xd8, 0x65, 0x82,
+	0xdf, 0xfd, 0xda, 0x20, 0x4d, 0x65, 0xa5, 0xf0, 0x3e, 0x75, 0xd2, 0x81, 0x8c, 0x52, 0x6d, 0x86,
+	0x6d, 0xf0, 0x16, 0xf7, 0x58, 0xa6, 0x87, 0x36, 0x83, 0x26, 0xf4, 0x4a, 0xe3, 0x58, 0xbe, 0x04,
+	0xda, 0x0c, 0xdb, 0x10, 0x14, 0xe3, 0xe8, 0xa1, 0x9f, 0x1d, 0xa2, 0x61, 0xb7, 0x98, 0xa2, 0xa0,
+	0x6f, 0x92, 0xf8, 0x3a, 0x09, 0x62, 0x1b, 0xfa, 0x26, 0x98, 0x4a, 0x54, 0xfa, 0x53, 0x14, 0xcc,
+	0xc4, 0x9f, 0x73, 0xb4, 0xd3, 0x36, 0x83, 0x66, 0xf7, 0x17, 0x06, 0x59, 0xa8, 0xb8, 0x02, 0x8c,
+	0x16, 0x95, 0x09, 0x10, 0xdb, 0xa0, 0x95, 0x97, 0xde, 0x9c, 0xfb, 0x1e, 0x70, 0x06, 0xbe, 0x4e,
+	0x68, 0xd0, 0x04, 0x3d, 0x0e, 0x9d, 0xd4, 0xbb, 0x9b, 0xe7, 0x8a, 0x07, 0xdd, 0xea, 0x8a, 0xa7,
+	0xfa, 0x65, 0x79, 0xb9, 0xda, 0x4c, 0xf5, 0xcb, 0xa0, 0x5f, 0x53, 0xf1, 0x06, 0xbe, 0xd7, 0xfd,
+	0x86, 0x90, 0x76, 0x89, 0x1d, 0xf5, 0xab, 0x5e, 0xad, 0x0a, 0xda, 0x74, 0x99, 0x98, 0x6a, 0x51,
+	0x6d, 0x66, 0xca, 0x51, 0x70, 0xe5, 0xb5, 0xca, 0xca, 0x57, 0x49, 0xdd, 0x0f, 0xa1, 0xde, 0x20,
+	0x0f, 0x52, 0x12, 0x90, 0xbd, 0xdc, 0x24, 0x97, 0x41, 0x58, 0x62, 0xde, 0x82, 0x06, 0x1b, 0x95,
+	0x3e, 0x2d, 0xc5, 0x0d, 0x34, 0x8f, 0x2a, 0x8b, 0x7e, 0x5f, 0xfb, 0x4d, 0x0b, 0xfd, 0xe6, 0xfa,
+	0x69, 0x70, 0x50, 0xe1, 0x39, 0xf7, 0xb1, 0x8c, 0x12, 0x88, 0x03, 0x74, 0xf9, 0xe5, 0x3b, 0x37,
+	0x4e, 0xd2, 0x7e, 0x84, 0xbd, 0x99, 0xd2, 0x02, 0x83, 0x94, 0x41, 0xc2, 0xc3, 0xa0, 0x50, 0x63,
+	0x9a, 0x44, 0x93, 0xe9, 0x27, 0x19, 0x7a, 0xba, 0xc9, 0xb0, 0x0d, 0xbc, 0x63, 0xe0, 0x2d, 0x4a,
+	0x1e, 0xb4, 0x75, 0xb0, 0x5e, 0x2a, 0x83, 0xf5, 0x55, 0xd2, 0x8e, 0xb8, 0x60, 0xee, 0x91, 0xb7,
+	0x97, 0xa1, 0x53, 0x9a, 0xac, 0x64, 0x28, 0x69, 0x8f, 0x47, 0x62, 0x2f, 0xb3, 0x57, 0x0a, 0xa9,
+	0x64, 0x40, 0x18, 0x53, 0x5d, 0xb7, 0x13, 0xe9, 0x82, 0x26, 0xab, 0x70, 0x94, 0x1c, 0x3a, 0x6f,
+	0x27, 0xd2, 0xd9, 0x4c, 0x56, 0xe1, 0xc0, 0x7e, 0x20, 0xf6, 0xc2, 0x9b, 0x87, 0xa2, 0x50, 0x93,
+	0x30, 0x6f, 0x86, 0x68, 0x0a, 0x64, 0x97, 0xe4, 0xbc, 0x05, 0xa3, 0x78, 0x0d, 0x81, 0x70, 0x55,
+	0x5e, 0xa1, 0xa6, 0xc1, 0xf8, 0x43, 0x1e, 0xc2, 0x3b, 0xe9, 0x05, 0xbc, 0x3d, 0x45, 0x81, 0x4e,
+	0xc8, 0xc3, 0x1d, 0xc7, 0x3d, 0xe0, 0xf6, 0x65, 0x94, 0x14, 0x74, 0x91, 0x9e, 0x5e, 0x3c, 0x6d,
+	0x7a, 0xb2, 0x49, 0x33, 0x13, 0x4e, 0x0a, 0x17, 0x61, 0xcb, 0x8b, 0x50, 0x64, 0x35, 0x66, 0xbc,
+	0x34, 0x1a, 0x33, 0xc0, 0x8a, 0x9d, 0x41, 0x66, 0xaf, 0x49, 0xdf, 0x87, 0x36, 0x6c, 0x13, 0x8d,
+	0x12, 0x01, 0xda, 0x15, 0xb4, 0xd2, 0x92, 0x81, 0x38, 0x0b, 0x88, 0xa7, 0xce, 0xc0, 0xbe, 0x8a,
+	0xc2, 0x82, 0x06, 0x4b, 0xc5, 0xf6, 0x43, 0x7f, 0xc0, 0x33, 0x61, 0xbf, 0x2c, 0xa3, 0x69, 0x85,
+	0x85, 0xc6, 0xa2, 0x52, 0xe8, 0x3a, 0x4e, 0xa9, 0x49, 0xb8, 0x16, 0x0e, 0x29, 0x3f, 0x89, 0xfd,
+	0x48, 0xd8, 0xaf, 0xa0, 0xb0, 0xc2, 0xa1, 0x0f, 0x49, 0x23, 0x70, 0xfa, 0x3c, 0xc8, 0xec, 0x0d,
+	0x84, 0x3c, 0xaf, 0x9f, 0x64, 0xa6, 0x5b, 0x1f, 0x61, 0x77, 0x85, 0x76, 0xa4, 0x2e, 0xbc, 0x18,
+	0x92, 0x38, 0x15, 0x99, 0x7d, 0xed, 0x74, 0x2f, 0x86, 0xbd, 0x38, 0x15, 0x4c, 0xaa, 0x40, 0x26,
+	0x50, 0xbd, 0x64, 0x02, 0xeb, 0x62, 0x6c, 0x18, 0xe1, 0xe1, 0xdb, 0xe1, 0x20, 0xe5, 0x8e, 0xca,
+	0x71, 0xaf, 0xca, 0x1c, 0x57, 0x61, 0x8d, 0x67, 0xc1, 0xd7, 0x26, 0xb3, 0xe0, 0x8f, 0xc9, 0x8a,
+	0x88, 0x13, 0x78, 0x63, 0x17, 0x35, 0xbf, 0xeb, 0x1b, 0xb5, 0x39, 0xcf, 0xfd, 0x72, 0xb5, 0x92,
+	0xcd, 0xc6, 0xf5, 0xd5, 0x90, 0x8f, 0x79, 0x58, 0x0e, 0x79, 0xe3, 0xec, 0x43, 0x56, 0xf5, 0x01,
+	0xa8, 0x55, 0x0e, 0xf8, 0x24, 0xa0, 0xd6, 0xae, 0x02, 0xb5, 0x1f, 0x92, 0xa5, 0x72, 0xfc, 0x38,
+	0x15, 0x98, 0x2f, 0xe2, 0x54, 0xd7, 0x1f, 0xb0, 0x0d, 0x76, 0x86, 0x75, 0x5f, 0x37, 0x0e, 0xf4,
+	0x2b, 0x41, 0xd3, 0xdd, 0x2f, 0x0d, 0xd2, 0x19, 0x5f, 0xa1, 0x06, 0x78, 0x46, 0x09, 0xf0, 0x46,
+	0x01, 0x8d, 0x39, 0x01, 0x68, 0xa6, 0x85, 0xe7, 0xcb, 0xa4, 0xe1, 0xca, 0x82, 0x87, 0xac, 0x55,
+	0x28, 0xaa, 0xe2, 0xc1, 0xf5, 0xaa, 0x07, 0x77, 0xff, 0xd0, 0x2a, 0x92, 0x15, 0x02, 0x8a, 0xb3,
+	0xaf, 0xa2, 0xc4, 0x78, 0xb5, 0x73, 0x62, 0x3c, 0xeb, 0xf4, 0x18, 0x0f, 0xb6, 0xec, 0xbb, 0xba,
+	0x7a, 0x80, 0x6d, 0xf0, 0x49, 0x69, 0xa0, 0x99, 0x4a, 0x77, 0x9a, 0x1c, 0xb7, 0xd5, 0xd6, 0xa4,
+	0xad, 0xaa, 0x6b, 0x6f, 0x97, 0xa1, 0x7b, 0x0c, 0x51, 0x91, 0x49, 0x44, 0xf5, 0x78, 0xac, 0x28,
+	0xc6, 0xed, 0x85, 0xb3, 0xa4, 0xad, 0x31, 0x65, 0xfa, 0xa3, 0xc2, 0x2d, 0x7b, 0x67, 0xc5, 0x8e,
+	0x23, 0x8a, 0x74, 0x8f, 0xac, 0xb8, 0xa3, 0x39, 0xce, 0x5e, 0x39, 0x53, 0x46, 0x1c, 0x57, 0x87,
+	0x97, 0x6b, 0xc1, 0x62, 0xfd, 0x22, 0x1b, 0x8d, 0x32, 0x47, 0x7a, 0x7d, 0xd2, 0x2f, 0x72, 0xd2,
+	0x28, 0x73, 0x02, 0x87, 0xd2, 0x29, 0x38, 0xb4, 0x04, 0xc1, 0x97, 0xce, 0x02, 0x82, 0xb7, 0x08,
+	0x2d, 0x86, 0xf9, 0xb8, 0x48, 0xbb, 0x32, 0x87, 0x4d, 0x91, 0x8c, 0xf7, 0x57, 0x89, 0xf8, 0x85,
+	0xc9, 0xfe, 0x52, 0x02, 0xb5, 0xbd, 0xf1, 0x51, 0x20, 0xf5, 0x5e, 0x96, 0xb5, 0xbd, 0x29, 0xa2,
+	0x71, 0x0d, 0x9d, 0xac, 0x5f, 0x9c, 0xd4, 0x50, 0xa2, 0x99, 0x10, 0xdc, 0x3e, 0x17, 0x04, 0x7f,
+	0xe9, 0xb4, 0x10, 0x7c, 0xed, 0x64, 0x08, 0x7e, 0x65, 0x06, 0x04, 0xff, 0xc6, 0xaa, 0x84, 0x41,
+	0xbc, 0x07, 0x09, 0x1f, 0x8d, 0x02, 0x3e, 0x56, 0x90, 0x88, 0x39, 0x07, 0x89, 0xd4, 0xe6, 0x21,
+	0x11, 0x6b, 0x0c, 0x89, 0xcc, 0x03, 0x9a, 0x65, 0x8c, 0x6b, 0xcc, 0x44, 0x29, 0xcd, 0x31, 0x94,
+	0x22, 0x65, 0x72, 0xbc, 0x56, 0x21, 0x93, 0xe3, 0x69, 0xfc, 0xd7, 0x9e, 0x82, 0xff, 0x48, 0x05,
+	0xff, 0x8d, 0xa0, 0xbd, 0x85, 0xb9, 0x68, 0x6f, 0x71, 0x3e, 0xda, 0x5b, 0x3a, 0x01, 0xed, 0x2d,
+	0x4f, 0xa0, 0xbd, 0x02, 0x3a, 0xaf, 0xfc, 0x4f, 0xd0, 0xb9, 0x73, 0x2e, 0xe8, 0xac, 0xa2, 0xe7,
+	0xc5, 0x32, 0x7a, 0x56, 0x30, 0x1c, 0x9d, 0x89, 0xe1, 0x2e, 0x8d, 0x18, 0x5d, 0xf7, 0x8f, 0x06,
+	0x21, 0x65, 0x1d, 0x1a, 0x4e, 0x38, 0xcf, 0x0b, 0x3b, 0xc2, 0x36, 0xbd, 0x45, 0xcc, 0x58, 0x17,
+	0x0a, 0x67, 0x05, 0x85, 0x27, 0x3d, 0x50, 0x67, 0x66, 0x0c, 0xce, 0x64, 0xb9, 0xb2, 0x30, 0x5a,
+	0x9b, 0x9f, 0x58, 0x50, 0x03, 0xfb, 0x8e, 0x57, 0x4d, 0xeb, 0x93, 0x55, 0xd3, 0x35, 0xa8, 0xc2,
+	0x7d, 0x9e, 0xf3, 0xc8, 0xe5, 0xaa, 0x50, 0x5f, 0xd0, 0xdd, 0xbf, 0xb5, 0xc8, 0x42, 0xb5, 0xf0,
+	0xb9, 0x4a, 0xea, 0x50, 0x52, 0x7e, 0x13, 0x77, 0x61, 0x32, 0x49, 0x68, 0xee, 0xdb, 0xca, 0x1d,
+	0x24, 0x01, 0x66, 0x8b, 0xe2, 0xb7, 0x95, 0x27, 0x28, 0xaa, 0xea, 0x3e, 0xd6, 0x1c, 0xf7, 0xa9,
+	0x8f, 0xbb, 0x8f, 0x4d, 0x9a, 0xbe, 0x17, 0x70, 0x90, 0x35, 0xa4, 0x9e, 0x22, 0x41, 0x02, 0x19,
+	0x13, 0x24, 0x4d, 0x29, 0x51, 0x24, 0x62, 0xe6, 0xf8, 0xd8, 0xf1, 0xc5, 0x9e, 0x2b, 0xfd, 0xc0,
+	0x64, 0x25, 0x03, 0x56, 0xe8, 0xa7, 0x9f, 0x83, 0x48, 0xba, 0x82, 0xa2, 0xc0, 0x38, 0xb3, 0x78,
+	0x5f, 0x28, 0x99, 0x74, 0x89, 0x0a, 0x07, 0x4f, 0x4c, 0x70, 0xe9, 0xc8, 0xd2, 0x2f, 0x0a, 0x1a,
+	0x64, 0x83, 0x9c, 0x67, 0x38, 0xa1, 0xf4, 0x8a, 0x82, 0x56, 0x4e, 0xf9, 0x14, 0xce, 0xde, 0x5e,
+	0x2a, 0x9c, 0x12, 0x69, 0xc8, 0x23, 0x21, 0x0f, 0x1f, 0x1c, 0x39, 0x7e, 0xe0, 0xf4, 0x03, 0x8e,
+	0x2e, 0x61, 0xb1, 0x11, 0x9e, 0xfc, 0x2a, 0x1e, 0x3e, 0xcb, 0xb8, 0x87, 0x6e, 0x61, 0x31, 0x4d,
+	0x2a, 0xc9, 0x07, 0x29, 0xe7, 0xaa, 0xb8, 0xa1, 0x49, 0xd8, 0x4b, 0xc8, 0xc3, 0xed, 0x7c, 0x7f,
+	0x9f, 0xa7, 0xba, 0x86, 0x51, 0xe1, 0xc0, 0x09, 0xe9, 0xa0, 0x21, 0x6d, 0xdb, 0x62, 0x25, 0x43,
+	0x49, 0x7b, 0x07, 0x4e, 0xca, 0x3d, 0xfb, 0x52, 0x21, 0x95, 0x0c, 0x35, 0x6b, 0x2f, 0x70, 0xfa,
+	0xf6, 0x6a, 0x31, 0x2b, 0x90, 0x78, 0x93, 0xc7, 0x4e, 0x22, 0xb7, 0x2a, 0xdf, 0x56, 0x25, 0x03,
+	0xcf, 0xef, 0xd8, 0x49, 0x70, 0x23, 0xea, 0x79, 0xa5, 0x69, 0x2d, 0xc3, 0xad, 0xbc, 0x58, 0xca,
+	0x70, 0x2f, 0x6a, 0xd4, 0xdd, 0x68, 0x3b, 0x91, 0x19, 0xc4, 0x64, 0x25, 0x03, 0x6f, 0xed, 0xd8,
+	0x49, 0x9e, 0xe4, 0x18, 0x52, 0x5e, 0x52, 0xb7, 0x56, 0x70, 0x30, 0x9f, 0x97, 0xd9, 0x62, 0x2f,
+	0xb3, 0xd7, 0x54, 0x3e, 0xaf, 0x32, 0x61, 0x4f, 0xfb, 0x71, 0x7a, 0x08, 0xf2, 0x2b, 0xd2, 0x96,
+	0x14, 0x09, 0xe3, 0x83, 0xa3, 0x65, 0x72, 0x53, 0x57, 0x11, 0x74, 0x55, 0x38, 0xfa, 0x1d, 0x92,
+	0xb1, 0x3c, 0x8a, 0xfc, 0x68, 0x60, 0xbf, 0x5c, 0xbe, 0x43, 0x34, 0x0f, 0xd6, 0x80, 0x74, 0x2f,
+	0xe0, 0x3c, 0x81, 0x4e, 0xeb, 0xb2, 0x66, 0x3e, 0xc2, 0x2c, 0x46, 0xda, 0x0e, 0x62, 0xf7, 0x90,
+	0x7b, 0xf6, 0x2b, 0x95, 0x91, 0x14, 0xaf, 0xe8, 0xd3, 0x13, 0x71, 0x92, 0x70, 0xcf, 0xde, 0xa8,
+	0xf4, 0x51, 0x3c, 0xf0, 0x7d, 0xa4, 0x3f, 0x8d, 0xc3, 0xbe, 0xcf, 0xed, 0x6b, 0x12, 0x27, 0x56,
+	0x58, 0xba, 0xca, 0x9c, 0xed, 0x7a, 0x01, 0x57, 0x0f, 0xa7, 0x92, 0x01, 0x73, 0x28, 0xc8, 0x29,
+	0xf7, 0x2c, 0x9f, 0x4d, 0x23, 0x3c, 0x38, 0x2f, 0x09, 0x3c, 0x33, 0x7c, 0x33, 0x59, 0x4c, 0x93,
+	0x98, 0xb6, 0x9c, 0xe7, 0x20, 0xb8, 0xae, 0xd2, 0x16, 0x52, 0xdd, 0xaf, 0x0c, 0xd2, 0x78, 0xd2,
+	0xd3, 0x31, 0x71, 0xa2, 0x84, 0x04, 0x0f, 0x8c, 0xc0, 0x11, 0xfb, 0x71, 0x1a, 0x16, 0x0f, 0x0c,
+	0x45, 0xc3, 0x90, 0xfb, 0x4e, 0xe8, 0x07, 0x43, 0xf5, 0x36, 0x50, 0x14, 0x2c, 0xe2, 0x88, 0xa7,
+	0x99, 0x1f, 0x47, 0xaa, 0x7c, 0xa3, 0x49, 0x38, 0xf0, 0x43, 0x9e, 0x46, 0x3c, 0xf8, 0x89, 0x92,
+	0xd7, 0x51, 0x3e, 0xca, 0xc4, 0x25, 0x49, 0xf0, 0x05, 0xd3, 0xc3, 0xee, 0xf0, 0x2b, 0xa7, 0x0c,
+	0x72, 0x05, 0x0d, 0xa7, 0x75, 0x0c, 0x1f, 0x3b, 0x51, 0x28, 0x63, 0x5d, 0xc9, 0x80, 0xa9, 0xd2,
+	0x91, 0xaf, 0xaa, 0x32, 0xec, 0x8d, 0x32, 0xe9, 0x0d, 0xb2, 0x7c, 0x3c, 0xfa, 0x2d, 0x55, 0x06,
+	0xc1, 0x31, 0x6e, 0xf7, 0x1f, 0x06, 0x21, 0xe5, 0xd7, 0xff, 0x29, 0xef, 0x97, 0x65, 0x62, 0xee,
+	0xeb, 0x4a, 0x9b, 0xb9, 0xef, 0x8d, 0x9d, 0x4d, 0xbd, 0x38, 0x9b, 0x29, 0x7f, 0x73, 0xa1, 0x6f,
+	0x92, 0x7a, 0xe0, 0x78, 0x5e, 0x7a, 0xc2, 0x27, 0xa3, 0x07, 0x9e, 0x97, 0x32, 0xd9, 0x13, 0x54,
+	0x52, 0x54, 0x69, 0x9c, 0x42, 0x05, 0x7b, 0xc2, 0x8a, 0xd4, 0x5f, 0x75, 0x9a, 0xf2, 0xb6, 0x24,
+	0xd5, 0xfd, 0x19, 0xb1, 0xa0, 0x5b, 0x51, 0x49, 0x31, 0x4e, 0x5b, 0x49, 0x01, 0x20, 0x96, 0x14,
+	0x75, 0xbc, 0xa4, 0x78, 0x9f, 0xd6, 0xca, 0xf7, 0x69, 0xf7, 0x77, 0x06, 0x21, 0xe5, 0x93, 0x0c,
+	0xce, 0x2d, 0xcd, 0xe4, 0xa7, 0x29, 0x8b, 0x41, 0x13, 0x38, 0x47, 0xa1, 0x4c, 0xba, 0x16, 0x83,
+	0x26, 0x0c, 0x03, 0x61, 0x42, 0x7d, 0x2b, 0xc7, 0x36, 0xae, 0x5d, 0x46, 0x3d, 0x4b, 0x1a, 0xaf,
+	0xa4, 0xf0, 0x34, 0xf9, 0x73, 0xa1, 0x5e, 0x9b, 0xd8, 0x86, 0x11, 0x03, 0xbf, 0xaf, 0xc0, 0x19,
+	0x34, 0xa1, 0x17, 0x6c, 0x46, 0xa1, 0x32, 0x6c, 0xe3, 0x5f, 0x48, 0xfc, 0x54, 0x0c, 0x15, 0x1c,
+	0x93, 0x44, 0xf7, 0x57, 0x26, 0x69, 0xaa, 0x97, 0x20, 0x58, 0x71, 0xe0, 0x64, 0x62, 0x27, 0xc9,
+	0x95, 0x43, 0x68, 0x72, 0x04, 0x39, 0x9a, 0x63, 0xc8, 0xb1, 0x92, 0x4e, 0x6b, 0x73, 0xd2, 0xa9,
+	0x35, 0x9e, 0x4e, 0x01, 0x81, 0xe5, 0xe1, 0x53, 0xf5, 0xc2, 0x94, 0x0f, 0xcf, 0x0a, 0x87, 0xde,
+	0x55, 0x60, 0xa3, 0x31, 0xb7, 0x22, 0xd3, 0xf3, 0xa3, 0x41, 0xc0, 0xf5, 0x5b, 0x16, 0x35, 0x8a,
+	0xc7, 0x6c, 0xb3, 0xf2, 0x98, 0x5d, 0x23, 0x2d, 0x58, 0x16, 0xbe, 0xb5, 0x5b, 0x12, 0x64, 0x68,
+	0x1a, 0x03, 0x37, 0x2e, 0xab, 0xfa, 0x81, 0xa3, 0xe4, 0x40, 0x5d, 0x62, 0x64, 0x9a, 0x59, 0x61,
+	0x63, 0xd6, 0x11, 0x75, 0xff, 0x6d, 0xe0, 0x21, 0x63, 0xc8, 0xb9, 0x4c, 0x1a, 0x51, 0x1e, 0xf6,
+	0xd5, 0xbf, 0xd3, 0xea, 0x4c, 0x51, 0xc0, 0x3f, 0xe2, 0x91, 0x17, 0xa7, 0xca, 0xbe, 0x14, 0x35,
+	0x33, 0xe4, 0xac, 0x92, 0x7a, 0x18, 0x7b, 0x3c, 0xd0, 0xf5, 0x62, 0x24, 0x30, 0x47, 0x1c, 0x0c,
+	0x33, 0xdf, 0x75, 0x02, 0xf5, 0x15, 0xba, 0xcd, 0x2a, 0x1c, 0x18, 0xcd, 0x8d, 0x53, 0xae, 0x3e,
+	0x44, 0xb7, 0x99, 0xa2, 0xe4, 0x5f, 0x3d, 0x52, 0xae, 0x5f, 0xfa, 0x92, 0x00, 0xc3, 0x0a, 0x0f,
+	0xbe, 0x50, 0xe7, 0x05, 0x4d, 0xb8, 0x52, 0x17, 0x32, 0x33, 0x7e, 0x08, 0x6e, 0xcb, 0x78, 0x5d,
+	0x30, 0xba, 0x7f, 0x31, 0x88, 0xf5, 0x48, 0x3b, 0x8a, 0x0e, 0x16, 0xa6, 0x5f, 0xf9, 0xe7, 0x8d,
+	0x59, 0xfd, 0xe7, 0xcd, 0xb4, 0x3a, 0xcb, 0x5b, 0xaa, 0xf0, 0x68, 0xe1, 0xad, 0xbf, 0x32, 0xc7,
+	0x27, 0x9f, 0x3a, 0x83, 0x4c, 0x55, 0x26, 0x6d, 0xd2, 0x74, 0x82, 0x00, 0x18, 0x68, 0x2d, 0x6d,
+	0xa6, 0xc9, 0xea, 0xd7, 0xfc, 0xe6, 0xdc, 0xaf, 0xf9, 0xad, 0x09, 0x5c, 0xda, 0xbd, 0x4f, 0x5a,
+	0x7a, 0x1e, 0x89, 0xc8, 0xf2, 0xd4, 0xe5, 0x4f, 0x75, 0x6d, 0x7f, 0x89, 0x55, 0x38, 0x45, 0xbd,
+	0xd4, 0x2c, 0xeb, 0xa5, 0x37, 0x7d, 0xb2, 0x3c, 0xfa, 0x3c, 0xa0, 0x0b, 0xa4, 0x99, 0x47, 0x87,
+	0x51, 0x7c, 0x1c, 0x75, 0x2e, 0x00, 0xa1, 0x0a, 0xe2, 0x1d, 0x83, 0x2e, 0x13, 0x92, 0x72, 0x84,
+	0xf4, 0x7e, 0x34, 0xe8, 0x98, 0x20, 0x4c, 0x65, 0xca, 0xee, 0xd4, 0x28, 0x21, 0x8d, 0xc4, 0xc9,
+	0x33, 0xee, 0x75, 0x2c, 0x68, 0xf3, 0xe7, 0x3e, 0x28, 0xd5, 0x69, 0x8b, 0x58, 0x1e, 0x77, 0xbc,
+	0x4e, 0xe3, 0xe6, 0xc7, 0x64, 0xa5, 0x98, 0x4a, 0xd5, 0x18, 0x2e, 0x92, 0x25, 0x35, 0x97, 0x64,
+	0x74, 0x2e, 0xd0, 0x45, 0xd2, 0x2a, 0xa6, 0x30, 0x60, 0x0a, 0xf9, 0xdc, 0x18, 0x76, 0x4c, 0xba,
+	0x44, 0xda, 0x79, 0xa4, 0xc9, 0xda, 0xcd, 0x0f, 0xc8, 0x62, 0xb5, 0x20, 0x42, 0xeb, 0xc4, 0x78,
+	0xd6, 0xb9, 0x00, 0x3f, 0x0f, 0x3b, 0x06, 0xfc, 0xb0, 0x8e, 0x09, 0x3f, 0xbd, 0x4e, 0x0d, 0x7e,
+	0x9e, 0x76, 0x2c, 0xf8, 0xf9, 0xa4, 0x53, 0x87, 0x9f, 0x9f, 0x76, 0x1a, 0xf0, 0xf3, 0x69, 0xa7,
+	0xb9, 0xfd, 0xde, 0x9f, 0xbe, 0x5d, 0x37, 0xfe, 0xfa, 0xed, 0xba, 0xf1, 0xcf, 0x6f, 0xd7, 0x8d,
+	0xaf, 0xfe, 0xb5, 0x7e, 0xe1, 0xd3, 0xad, 0x29, 0xff, 0xf1, 0x54, 0x77, 0x7c, 0x4b, 0xdd, 0xf1,
+	0x2d, 0xbc, 0xe3, 0xdb, 0x68, 0xd0, 0xfd, 0x06, 0x16, 0xf7, 0xde, 0xfa, 0xef, 0x00, 0x4d, 0xca,
+	0x5f, 0xeb, 0x40, 0x2a, 0x00, 0x00,
 }
@@ -9,6 +9,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/DataDog/zstd"
 	"github.com/gogo/protobuf/jsonpb"
@@ -38,7 +39,12 @@ const (
 	MessageV3                = 3
 )
 
-const headerLength = 1 + 1 + 1 + 1 + 4
+// headerLengths are the lengths in bytes of the header of each MessageVersion.
+var headerLengths = map[MessageVersion]int{
+	MessageV1: 1 + 1 + 1 + 1,
+	MessageV2: 1 + 1 + 1 + 1 + 4,
+	MessageV3: 1 + 1 + 1 + 1 + 4 + 8,
+}
 
 // MessageHeader is attached to all messages at the head of the message. Some
 // fields are added in later versions so make sure you're only using fields that
@@ -49,7 +55,7 @@ type MessageHeader struct {
 	Type           MessageType
 	SubscriptionID uint8 // Unused in Agent
 	OrgID          int32 // Unused in Agent
-	Timestamp      int64
+	Timestamp      int64 // Milliseconds since the epoch, only in V3
 }
 
 func unmarshal(enc MessageEncoding, body []byte, m proto.Message) error {
@@ -81,6 +87,7 @@ const (
 	TypeCollectorRealTime          = 27
 	TypeCollectorContainer         = 39
 	TypeCollectorContainerRealTime = 40
+	TypeCollectorSystemStats       = 41
 )
 
 // Message is a generic type for all messages with a Header and Body.
@@ -97,6 +104,24 @@ type MessageBody interface {
 	Size() int
 }
 
+// NewMessage wraps a body in a Message with a header of the given version and
+// encoding, timestamped with the current time.
+func NewMessage(version MessageVersion, enc MessageEncoding, body MessageBody) (Message, error) {
+	msgType, err := DetectMessageType(body)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Header: MessageHeader{
+			Version:   version,
+			Encoding:  enc,
+			Type:      msgType,
+			Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+		},
+		Body: body,
+	}, nil
+}
+
 // DecodeMessage decodes raw message bytes into a specific type that satisfies
 // the Message interface. If we can't decode, an error is returned.
 func DecodeMessage(data []byte) (Message, error) {
@@ -119,6 +144,8 @@ func DecodeMessage(data []byte) (Message, error) {
 		m = &CollectorContainer{}
 	case TypeCollectorContainerRealTime:
 		m = &CollectorContainerRealTime{}
+	case TypeCollectorSystemStats:
+		m = &CollectorSystemStats{}
 	default:
 		return Message{}, fmt.Errorf("unhandled message type: %d", header.Type)
 	}
@@ -144,14 +171,16 @@ func DetectMessageType(b MessageBody) (MessageType, error) {
 		t = TypeCollectorContainer
 	case *CollectorContainerRealTime:
 		t = TypeCollectorContainerRealTime
+	case *CollectorSystemStats:
+		t = TypeCollectorSystemStats
 	default:
 		return 0, fmt.Errorf("unknown message body type: %s", reflect.TypeOf(b))
 	}
 	return t, nil
 }
 
-// EncodeMessage encodes a message object into bytes with protobuf. A type
-// header is added for ease of decoding.
+// EncodeMessage encodes a message object into bytes with the version and
+// encoding of its header. The header is added for ease of decoding.
 func EncodeMessage(m Message) ([]byte, error) {
 	hb, err := encodeHeader(m.Header)
 	if err != nil {
@@ -195,14 +224,20 @@ func EncodeMessage(m Message) ([]byte, error) {
 
 // ReadHeader reads the header off raw message bytes.
 func ReadHeader(data []byte) (MessageHeader, int, error) {
-	if len(data) <= 4 {
+	if len(data) == 0 {
 		return MessageHeader{}, 0, fmt.Errorf("invalid message length: %d", len(data))
 	}
-	switch MessageVersion(uint8(data[0])) {
+	version := MessageVersion(uint8(data[0]))
+	if n, ok := headerLengths[version]; ok && len(data) < n {
+		return MessageHeader{}, 0, fmt.Errorf("invalid message length for version %d: %d", version, len(data))
+	}
+	switch version {
 	case MessageV1:
 		return readHeaderV1(data)
 	case MessageV2:
 		return readHeaderV2(data)
+	case MessageV3:
+		return readHeaderV3(data)
 	default:
 		return MessageHeader{}, 0, fmt.Errorf("invalid message version: %d", uint8(data[0]))
 	}
@@ -291,39 +326,22 @@ func readHeaderV3(data []byte) (MessageHeader, int, error) {
 }
 
 func encodeHeader(h MessageHeader) ([]byte, error) {
+	fields := []interface{}{uint8(h.Version), uint8(h.Encoding), uint8(h.Type), h.SubscriptionID}
 	switch h.Version {
+	case MessageV1:
+	case MessageV2:
+		fields = append(fields, h.OrgID)
 	case MessageV3:
-		return encodeHeaderV3(h)
+		fields = append(fields, h.OrgID, h.Timestamp)
 	default:
 		return nil, fmt.Errorf("invalid message version: %d", h.Version)
 	}
-}
 
-func encodeHeaderV3(h MessageHeader) ([]byte, error) {
 	b := new(bytes.Buffer)
-	err := binary.Write(b, binary.LittleEndian, uint8(h.Version))
-	if err != nil {
-		return nil, err
-	}
-	err = binary.Write(b, binary.LittleEndian, uint8(h.Encoding))
-	if err != nil {
-		return nil, err
-	}
-	err = binary.Write(b, binary.LittleEndian, uint8(h.Type))
-	if err != nil {
-		return nil, err
-	}
-	err = binary.Write(b, binary.LittleEndian, uint8(h.SubscriptionID))
-	if err != nil {
-		return nil, err
-	}
-	err = binary.Write(b, binary.LittleEndian, h.OrgID)
-	if err != nil {
-		return nil, err
-	}
-	err = binary.Write(b, binary.LittleEndian, h.Timestamp)
-	if err != nil {
-		return nil, err
+	for _, f := range fields {
+		if err := binary.Write(b, binary.LittleEndian, f); err != nil {
+			return nil, err
+		}
 	}
 	return b.Bytes(), nil
 }
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	allVersions  = []MessageVersion{MessageV1, MessageV2, MessageV3}
+	allEncodings = []MessageEncoding{MessageEncodingProtobuf, MessageEncodingJSON, MessageEncodingZstdPB}
+)
+
+// newFuzzer returns a Fuzzer only generating the defined values of the enums,
+// as unknown values can't be represented in JSON. Pointers are always set as
+// repeated fields can't hold nil messages.
+func newFuzzer() *fuzz.Fuzzer {
+	return fuzz.New().NilChance(0).NumElements(0, 3).Funcs(
+		func(s *ContainerState, c fuzz.Continue) { *s = ContainerState(c.Intn(len(ContainerState_name))) },
+		func(s *ContainerHealth, c fuzz.Continue) { *s = ContainerHealth(c.Intn(len(ContainerHealth_name))) },
+		func(s *ProcessState, c fuzz.Continue) { *s = ProcessState(c.Intn(len(ProcessState_name))) },
+	)
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	f := newFuzzer()
+	bodies := []func() MessageBody{
+		func() MessageBody { return &CollectorProc{} },
+		func() MessageBody { return &CollectorConnections{} },
+		func() MessageBody { return &CollectorRealTime{} },
+		func() MessageBody { return &ResCollector{} },
+		func() MessageBody { return &CollectorContainer{} },
+		func() MessageBody { return &CollectorContainerRealTime{} },
+		func() MessageBody { return &CollectorSystemStats{} },
+	}
+	for _, newBody := range bodies {
+		for i := 0; i < 20; i++ {
+			body := newBody()
+			f.Fuzz(body)
+			for _, version := range allVersions {
+				for _, enc := range allEncodings {
+					name := fmt.Sprintf("%T/v%d/enc%d", body, version, enc)
+					m, err := NewMessage(version, enc, body)
+					assert.NoError(t, err, name)
+					m.Header.OrgID = 42
+
+					data, err := EncodeMessage(m)
+					if !assert.NoError(t, err, name) {
+						continue
+					}
+					decoded, err := DecodeMessage(data)
+					if !assert.NoError(t, err, name) {
+						continue
+					}
+
+					assert.Equal(t, version, decoded.Header.Version, name)
+					assert.Equal(t, enc, decoded.Header.Encoding, name)
+					assert.Equal(t, m.Header.Type, decoded.Header.Type, name)
+					if version >= MessageV2 {
+						assert.Equal(t, int32(42), decoded.Header.OrgID, name)
+					}
+					if version >= MessageV3 {
+						assert.Equal(t, m.Header.Timestamp, decoded.Header.Timestamp, name)
+					}
+					assert.True(t, proto.Equal(body, decoded.Body), name)
+				}
+			}
+		}
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	before := time.Now().UnixNano() / int64(time.Millisecond)
+	m, err := NewMessage(MessageV3, MessageEncodingZstdPB, &CollectorSystemStats{})
+	assert.NoError(t, err)
+	assert.Equal(t, MessageType(TypeCollectorSystemStats), m.Header.Type)
+	assert.True(t, m.Header.Timestamp >= before)
+
+	_, err = NewMessage(MessageV3, MessageEncodingZstdPB, &Process{})
+	assert.Error(t, err)
+}
+
+func TestReadHeaderErrors(t *testing.T) {
+	for _, data := range [][]byte{
+		{},
+		// Unknown version
+		{9, 0, 12, 0, 0, 0, 0, 0},
+		// Truncated V2 and V3 headers
+		{byte(MessageV2), 0, 12, 0, 0},
+		{byte(MessageV3), 0, 12, 0, 0, 0, 0, 0, 0, 0},
+	} {
+		_, _, err := ReadHeader(data)
+		assert.Error(t, err, "%v", data)
+	}
+
+	_, err := EncodeMessage(Message{Header: MessageHeader{Version: 9}, Body: &CollectorProc{}})
+	assert.Error(t, err)
+	_, err = EncodeMessage(Message{Header: MessageHeader{Version: MessageV3, Encoding: 9}, Body: &CollectorProc{}})
+	assert.Error(t, err)
+}
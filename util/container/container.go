@@ -3,19 +3,34 @@
 package container
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/cihub/seelog"
 
 	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/util/docker"
 	"github.com/DataDog/datadog-agent/pkg/util/ecs"
+	"github.com/DataDog/datadog-process-agent/util/cache"
 )
 
+// metadataCacheTTL is how long we keep the inspected metadata of a container.
+// Metadata doesn't change for the lifetime of a container so we only need to
+// expire it to avoid keeping around the entries of removed containers forever.
+const metadataCacheTTL = 10 * time.Minute
+
+// defaultCacheDuration is how long the containers listed are reused for, until
+// SetCacheDuration is called with the configured value.
+const defaultCacheDuration = 10 * time.Second
+
 var (
 	listeners []config.Listeners
+	podman    *podmanListener
 	// hasFatalError stores whether a listener has fatally error'd, to see if we should keep accessing its containers
 	hasFatalError map[string]bool
 )
@@ -23,12 +38,23 @@ var (
 // Unmarshal the listeners once and store the result
 func initContainerListeners() {
 	listeners = GetDefaultListeners()
+	// The podman paths are resolved now that HOST_ROOT and HOST_SYS are set
+	podman = newPodmanListener()
 	hasFatalError = make(map[string]bool)
 }
 
+// SetCacheDuration sets how long the container lists are reused for by the
+// listeners which don't cache them on their own.
+func SetCacheDuration(d time.Duration) {
+	if listeners == nil {
+		initContainerListeners()
+	}
+	podman.setCacheDuration(d)
+}
+
 // GetDefaultListeners returns the default auto-discovery listeners, for use in container retrieval
 func GetDefaultListeners() []config.Listeners {
-	l := []config.Listeners{{Name: "docker"}}
+	l := []config.Listeners{{Name: "docker"}, {Name: "podman"}}
 	// If we can detect that this is a fargate instance, lets add it as well
 	if ecs.IsFargateInstance() {
 		l = append(l, config.Listeners{Name: "ecs"})
@@ -73,6 +99,21 @@ func GetContainers() ([]*docker.Container, error) {
 				}
 				errs = append(errs, fmt.Errorf("unable to connect to docker - %s", err))
 			}
+		case "podman": // Rootful and rootless podman containers of all users
+			if ctrs, err := podman.Containers(); err == nil {
+				succeeded = true
+				containers = append(containers, ctrs...)
+			} else {
+				if strings.HasPrefix(err.Error(), "permanent failure") {
+					// Logged once as podman is a default listener, disabled for
+					// the lifetime of the agent
+					log.Infof("Podman containers won't be collected: %s", err)
+					hasFatalError[l.Name] = true
+				} else if err == docker.ErrNotImplemented {
+					hasFatalError[l.Name] = true
+				}
+				errs = append(errs, fmt.Errorf("failed to get container list from podman - %s", err))
+			}
 		case "ecs": // Fargate containers
 			if ctrs, err := ecs.GetContainers(); err != nil {
 				errs = append(errs, fmt.Errorf("failed to get container list from fargate - %s", err))
@@ -93,3 +134,92 @@ func GetContainers() ([]*docker.Container, error) {
 
 	return containers, errors.New("failed to get containers from any source")
 }
+
+// GetMetadata returns the image, command, label and port metadata of the given
+// container. Fields that can be derived from the container list are always filled,
+// the others are only available for Docker containers and are fetched by inspecting
+// the container. Results are cached as they don't change over a container's lifetime.
+func GetMetadata(ctr *docker.Container) (*Metadata, error) {
+	cacheKey := "container_metadata:" + ctr.ID
+	if m, ok := cache.Get(cacheKey); ok {
+		return m.(*Metadata), nil
+	}
+
+	// The image ID is the local ID of the image config, which differs between
+	// the hosts, so the digest is only known from a registry reference.
+	m := &Metadata{}
+	m.ImageName, m.ImageTag, m.ImageDigest = SplitImageName(ctr.Image)
+	if ctr.Type != "docker" {
+		cache.SetWithTTL(cacheKey, m, metadataCacheTTL)
+		return m, nil
+	}
+
+	du, err := docker.GetDockerUtil()
+	if err != nil {
+		return m, err
+	}
+	co, err := du.Inspect(ctr.ID, false)
+	if err != nil {
+		return m, fmt.Errorf("could not inspect container %s: %s", ctr.ID, err)
+	}
+	if co.Config != nil {
+		name, tag, digest := SplitImageName(co.Config.Image)
+		if m.ImageName == "" {
+			m.ImageName, m.ImageTag = name, tag
+		}
+		if m.ImageDigest == "" {
+			m.ImageDigest = digest
+		}
+		m.Command = co.Config.Cmd
+		m.Entrypoint = co.Config.Entrypoint
+		m.Labels = co.Config.Labels
+		for p := range co.Config.ExposedPorts {
+			port, err := strconv.Atoi(p.Port())
+			if err != nil {
+				continue
+			}
+			m.Ports = append(m.Ports, Port{Port: port, Protocol: p.Proto()})
+		}
+		sort.Slice(m.Ports, func(i, j int) bool { return m.Ports[i].Port < m.Ports[j].Port })
+	}
+	if m.ImageDigest == "" && co.ContainerJSONBase != nil && co.Image != "" {
+		m.ImageDigest = imageRepoDigest(co.Image, m.ImageName)
+	}
+
+	cache.SetWithTTL(cacheKey, m, metadataCacheTTL)
+	return m, nil
+}
+
+// imageRepoDigest returns the registry digest of a local image from its repo
+// digests, preferring the one of the repository the container was created from,
+// or "" when the image wasn't pulled from or pushed to a registry. Results are
+// cached by image as the repo digests are shared by its containers.
+func imageRepoDigest(imageID, name string) string {
+	cacheKey := "image_repo_digest:" + imageID
+	if d, ok := cache.Get(cacheKey); ok {
+		return d.(string)
+	}
+
+	cli, err := docker.ConnectToDocker()
+	if err != nil {
+		return ""
+	}
+	image, _, err := cli.ImageInspectWithRaw(context.Background(), imageID)
+	if err != nil {
+		log.Debugf("could not inspect image %s: %s", imageID, err)
+		return ""
+	}
+	digest := ""
+	for _, repoDigest := range image.RepoDigests {
+		repo, _, d := SplitImageName(repoDigest)
+		if repo == name {
+			digest = d
+			break
+		}
+		if digest == "" {
+			digest = d
+		}
+	}
+	cache.SetWithTTL(cacheKey, digest, metadataCacheTTL)
+	return digest
+}
@@ -3,10 +3,15 @@
 package container
 
 import (
+	"time"
+
 	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/util/docker"
 )
 
+// SetCacheDuration sets how long the container lists are reused for.
+func SetCacheDuration(d time.Duration) {}
+
 // GetDefaultListeners returns the default auto-discovery listeners, for use in container retrieval
 func GetDefaultListeners() []config.Listeners {
 	return nil
@@ -17,3 +22,8 @@ func GetDefaultListeners() []config.Listeners {
 func GetContainers() ([]*docker.Container, error) {
 	return make([]*docker.Container, 0), docker.ErrNotImplemented
 }
+
+// GetMetadata returns the image, command, label and port metadata of the given container.
+func GetMetadata(ctr *docker.Container) (*Metadata, error) {
+	return nil, docker.ErrNotImplemented
+}
@@ -0,0 +1,60 @@
+package container
+
+import (
+	"strings"
+)
+
+// Metadata holds the container attributes that are only available by inspecting
+// a container and that will not change over the container's lifetime.
+type Metadata struct {
+	ImageName   string
+	ImageTag    string
+	ImageDigest string
+	Command     []string
+	Entrypoint  []string
+	Labels      map[string]string
+	Ports       []Port
+}
+
+// Port is a port exposed by a container.
+type Port struct {
+	Port     int
+	Protocol string
+}
+
+// SplitImageName splits a full image reference (e.g. "registry:5000/nginx:1.13@sha256:abc")
+// into its name, tag and digest. The tag defaults to "latest" when the image is not
+// referenced by digest.
+func SplitImageName(image string) (name, tag, digest string) {
+	name = image
+	if i := strings.Index(name, "@"); i != -1 {
+		name, digest = name[:i], name[i+1:]
+	}
+	// The tag separator is the last ':' after the last '/', anything before can
+	// be a registry port.
+	if i := strings.LastIndex(name, ":"); i != -1 && i > strings.LastIndex(name, "/") {
+		name, tag = name[:i], name[i+1:]
+	}
+	if tag == "" && digest == "" && name != "" {
+		tag = "latest"
+	}
+	return name, tag, digest
+}
+
+// FilterLabels returns the labels with a key present in the whitelist. A whitelist
+// entry ending with '*' matches all the labels starting with the given prefix.
+func FilterLabels(labels map[string]string, whitelist []string) map[string]string {
+	if len(labels) == 0 || len(whitelist) == 0 {
+		return nil
+	}
+	filtered := make(map[string]string)
+	for k, v := range labels {
+		for _, w := range whitelist {
+			if k == w || (strings.HasSuffix(w, "*") && strings.HasPrefix(k, strings.TrimSuffix(w, "*"))) {
+				filtered[k] = v
+				break
+			}
+		}
+	}
+	return filtered
+}
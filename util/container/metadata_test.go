@@ -0,0 +1,45 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitImageName(t *testing.T) {
+	for _, tc := range []struct {
+		image, name, tag, digest string
+	}{
+		{"nginx", "nginx", "latest", ""},
+		{"nginx:1.13", "nginx", "1.13", ""},
+		{"datadog/agent:6.1.0", "datadog/agent", "6.1.0", ""},
+		{"localhost:5000/foo/bar", "localhost:5000/foo/bar", "latest", ""},
+		{"localhost:5000/foo/bar:v2", "localhost:5000/foo/bar", "v2", ""},
+		{"redis@sha256:0123abcd", "redis", "", "sha256:0123abcd"},
+		{"redis:4@sha256:0123abcd", "redis", "4", "sha256:0123abcd"},
+		{"", "", "", ""},
+	} {
+		name, tag, digest := SplitImageName(tc.image)
+		assert.Equal(t, tc.name, name, tc.image)
+		assert.Equal(t, tc.tag, tag, tc.image)
+		assert.Equal(t, tc.digest, digest, tc.image)
+	}
+}
+
+func TestFilterLabels(t *testing.T) {
+	labels := map[string]string{
+		"com.docker.compose.service": "web",
+		"com.docker.compose.project": "shop",
+		"org.label-schema.version":   "1.2.3",
+		"secret.token":               "hunter2",
+	}
+
+	assert.Nil(t, FilterLabels(labels, nil))
+	assert.Equal(t, map[string]string{
+		"org.label-schema.version": "1.2.3",
+	}, FilterLabels(labels, []string{"org.label-schema.version"}))
+	assert.Equal(t, map[string]string{
+		"com.docker.compose.service": "web",
+		"com.docker.compose.project": "shop",
+	}, FilterLabels(labels, []string{"com.docker.compose.*", "missing"}))
+}
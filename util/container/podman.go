@@ -0,0 +1,533 @@
+// +build docker,linux
+
+package container
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/docker"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// podmanScopeRegexp matches the systemd scope created for a podman container. We
+// skip the conmon scopes (libpod-conmon-<id>.scope) as they only hold the monitor.
+var podmanScopeRegexp = regexp.MustCompile(`^libpod-([0-9a-f]{64})\.scope$`)
+
+// podmanAPITimeout bounds each request to a podman API socket so an unresponsive
+// user service can't stall the checks.
+const podmanAPITimeout = 2 * time.Second
+
+// podmanAbsentRetryInterval is how long we wait before looking for podman
+// containers again on a host where podman isn't in use, as it takes a walk of
+// the cgroup tree to find out.
+const podmanAbsentRetryInterval = time.Minute
+
+// errPodmanNotInUse is returned when podman hasn't been run on the host.
+var errPodmanNotInUse = errors.New("podman is not in use on this host")
+
+// podmanContainer is the state we know about a podman container before it is
+// converted into a docker.Container. It is either found through a podman API socket,
+// the containers storage of a user or both.
+type podmanContainer struct {
+	ID      string
+	Name    string
+	Image   string
+	ImageID string
+	Created int64
+	State   string
+}
+
+// podmanListener finds rootful and rootless podman containers for all the users of
+// the host. Running containers are found through their cgroup scopes, which give us
+// their PIDs and resource usage; names and images come from the podman API socket
+// of the owning user when it's listening, and from its containers storage otherwise.
+//
+// The containers are cached for cacheDuration, as they are listed by several
+// checks each run, and a host without podman is only looked at again after
+// absentRetry.
+type podmanListener struct {
+	cgroupRoot string
+	runRoot    string
+	passwdPath string
+	storageDir string
+	// The host root the home directories of the passwd file are resolved in.
+	homeRoot string
+
+	cacheDuration time.Duration
+	absentRetry   time.Duration
+
+	mu         sync.Mutex
+	containers []*docker.Container
+	err        error
+	expiry     time.Time
+}
+
+func newPodmanListener() *podmanListener {
+	return &podmanListener{
+		cgroupRoot:    util.HostSys("fs/cgroup"),
+		runRoot:       util.HostRoot("run"),
+		passwdPath:    util.HostRoot("etc/passwd"),
+		storageDir:    util.HostRoot("var/lib/containers/storage"),
+		homeRoot:      util.HostRoot(),
+		cacheDuration: defaultCacheDuration,
+		absentRetry:   podmanAbsentRetryInterval,
+	}
+}
+
+// setCacheDuration sets how long the containers found are reused for.
+func (l *podmanListener) setCacheDuration(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cacheDuration = d
+}
+
+// Containers returns all the running podman containers on the host.
+func (l *podmanListener) Containers() ([]*docker.Container, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.expiry) {
+		return l.containers, l.err
+	}
+	l.containers, l.err = l.listContainers()
+	switch {
+	case l.err == errPodmanNotInUse:
+		l.expiry = now.Add(l.absentRetry)
+	case l.err == nil:
+		l.expiry = now.Add(l.cacheDuration)
+	default:
+		l.expiry = time.Time{}
+	}
+	return l.containers, l.err
+}
+
+func (l *podmanListener) listContainers() ([]*docker.Container, error) {
+	scopes, err := l.findScopes()
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 && !l.hasRuntimeDir() {
+		return nil, errPodmanNotInUse
+	}
+
+	users := l.users(scopes)
+	found := make([][]*podmanContainer, len(users))
+	var wg sync.WaitGroup
+	for i, u := range users {
+		wg.Add(1)
+		go func(i int, u podmanUser) {
+			defer wg.Done()
+			ctrs, err := l.apiContainers(u.socket)
+			if err != nil && u.storageDir != "" {
+				ctrs, _ = readStorageContainers(filepath.Join(u.storageDir, "overlay-containers", "containers.json"))
+			}
+			found[i] = ctrs
+		}(i, u)
+	}
+	wg.Wait()
+
+	states := make(map[string]*podmanContainer)
+	for _, ctrs := range found {
+		for _, c := range ctrs {
+			states[c.ID] = c
+		}
+	}
+
+	containers := make([]*docker.Container, 0, len(scopes))
+	for id, scope := range scopes {
+		pids := readCgroupPids(scope)
+		if len(pids) == 0 {
+			continue
+		}
+		ctr := &docker.Container{
+			Type:     "podman",
+			ID:       id,
+			EntityID: "podman://" + id,
+			State:    "running",
+			Pids:     pids,
+		}
+		if s, ok := states[id]; ok {
+			ctr.Name = s.Name
+			ctr.Image = s.Image
+			ctr.ImageID = s.ImageID
+			ctr.Created = s.Created
+			if s.State != "" {
+				ctr.State = s.State
+			}
+		}
+		fillCgroupStats(ctr, scope)
+		ctr.Network = readNetStats(pids[0])
+		containers = append(containers, ctr)
+	}
+	sort.Slice(containers, func(i, j int) bool { return containers[i].ID < containers[j].ID })
+	return containers, nil
+}
+
+// findScopes walks the user and machine slices of the unified cgroup hierarchy and
+// returns the cgroup directory of each podman container, keyed by container ID.
+// The cgroup v1 hierarchies aren't supported, as they can't hold rootless
+// containers and report their usage in other files.
+func (l *podmanListener) findScopes() (map[string]string, error) {
+	if !util.PathExists(filepath.Join(l.cgroupRoot, "cgroup.controllers")) {
+		return nil, fmt.Errorf("permanent failure: %s is not a cgroup v2 hierarchy, podman containers are only collected on cgroup v2 hosts", l.cgroupRoot)
+	}
+
+	scopes := make(map[string]string)
+	for _, slice := range []string{"user.slice", "machine.slice"} {
+		root := filepath.Join(l.cgroupRoot, slice)
+		if !util.PathExists(root) {
+			continue
+		}
+		filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil || !info.IsDir() {
+				return nil
+			}
+			if m := podmanScopeRegexp.FindStringSubmatch(info.Name()); m != nil {
+				scopes[m[1]] = path
+				return filepath.SkipDir
+			}
+			return nil
+		})
+	}
+	return scopes, nil
+}
+
+// hasRuntimeDir returns whether podman has been run by root or by any user since
+// boot, so that hosts without podman aren't reported as having containers access.
+func (l *podmanListener) hasRuntimeDir() bool {
+	if util.PathExists(filepath.Join(l.runRoot, "podman")) {
+		return true
+	}
+	matches, _ := filepath.Glob(filepath.Join(l.runRoot, "user", "*", "podman"))
+	return len(matches) > 0
+}
+
+type podmanUser struct {
+	socket     string
+	storageDir string
+}
+
+// users returns where to look for the container state of root and of every user
+// that owns a podman scope.
+func (l *podmanListener) users(scopes map[string]string) []podmanUser {
+	users := []podmanUser{{
+		socket:     filepath.Join(l.runRoot, "podman", "podman.sock"),
+		storageDir: l.storageDir,
+	}}
+
+	uids := make(map[string]bool)
+	for _, scope := range scopes {
+		if uid := scopeUID(scope); uid != "" {
+			uids[uid] = true
+		}
+	}
+	if len(uids) == 0 {
+		return users
+	}
+
+	homes := readHomeDirs(l.passwdPath)
+	for uid := range uids {
+		u := podmanUser{socket: filepath.Join(l.runRoot, "user", uid, "podman", "podman.sock")}
+		if home, ok := homes[uid]; ok {
+			u.storageDir = filepath.Join(l.homeRoot, home, ".local", "share", "containers", "storage")
+		}
+		users = append(users, u)
+	}
+	return users
+}
+
+// scopeUID extracts the owner's uid from a user slice path, e.g.
+//
+// 	.../user.slice/user-1000.slice/user@1000.service/user.slice/libpod-<id>.scope
+func scopeUID(scope string) string {
+	for _, part := range strings.Split(scope, string(filepath.Separator)) {
+		if strings.HasPrefix(part, "user-") && strings.HasSuffix(part, ".slice") {
+			return strings.TrimSuffix(strings.TrimPrefix(part, "user-"), ".slice")
+		}
+	}
+	return ""
+}
+
+// readHomeDirs returns the home directory of each uid in a passwd file.
+func readHomeDirs(path string) map[string]string {
+	homes := make(map[string]string)
+	lines, err := util.ReadLines(path)
+	if err != nil {
+		return homes
+	}
+	for _, line := range lines {
+		fields := strings.Split(line, ":")
+		if len(fields) < 6 {
+			continue
+		}
+		homes[fields[2]] = fields[5]
+	}
+	return homes
+}
+
+// podmanAPIContainer is the subset of the libpod container list response we use.
+type podmanAPIContainer struct {
+	ID      string          `json:"Id"`
+	Names   []string        `json:"Names"`
+	Image   string          `json:"Image"`
+	ImageID string          `json:"ImageID"`
+	Created json.RawMessage `json:"Created"`
+	State   string          `json:"State"`
+}
+
+// apiContainers lists the containers known to the podman API listening on socket.
+func (l *podmanListener) apiContainers(socket string) ([]*podmanContainer, error) {
+	if !util.PathExists(socket) {
+		return nil, fmt.Errorf("no podman socket at %s", socket)
+	}
+	client := &http.Client{
+		Timeout: podmanAPITimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+	resp, err := client.Get("http://podman/v1.0.0/libpod/containers/json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %s", socket, resp.Status)
+	}
+
+	var list []podmanAPIContainer
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("could not decode container list from %s: %s", socket, err)
+	}
+	ctrs := make([]*podmanContainer, 0, len(list))
+	for _, c := range list {
+		ctr := &podmanContainer{
+			ID:      c.ID,
+			Image:   c.Image,
+			ImageID: c.ImageID,
+			Created: parseCreated(c.Created),
+			State:   c.State,
+		}
+		if len(c.Names) > 0 {
+			ctr.Name = c.Names[0]
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	return ctrs, nil
+}
+
+// parseCreated handles both the unix timestamps of older podman versions and the
+// RFC3339 dates of newer ones.
+func parseCreated(raw json.RawMessage) int64 {
+	var ts int64
+	if err := json.Unmarshal(raw, &ts); err == nil {
+		return ts
+	}
+	var t time.Time
+	if err := json.Unmarshal(raw, &t); err == nil {
+		return t.Unix()
+	}
+	return 0
+}
+
+// storageContainer is an entry of the containers.json file in a containers storage.
+type storageContainer struct {
+	ID       string    `json:"id"`
+	Names    []string  `json:"names"`
+	Image    string    `json:"image"`
+	Metadata string    `json:"metadata"`
+	Created  time.Time `json:"created"`
+}
+
+// readStorageContainers reads the containers registered in a containers storage. It
+// has no runtime state, so it's only used to name the containers found in cgroups.
+func readStorageContainers(path string) ([]*podmanContainer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list []storageContainer
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("could not decode %s: %s", path, err)
+	}
+
+	ctrs := make([]*podmanContainer, 0, len(list))
+	for _, c := range list {
+		ctr := &podmanContainer{
+			ID:      c.ID,
+			ImageID: c.Image,
+			Created: c.Created.Unix(),
+		}
+		if len(c.Names) > 0 {
+			ctr.Name = c.Names[0]
+		}
+		var meta struct {
+			ImageName string `json:"image-name"`
+		}
+		if err := json.Unmarshal([]byte(c.Metadata), &meta); err == nil {
+			ctr.Image = meta.ImageName
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	return ctrs, nil
+}
+
+// readCgroupPids returns the PIDs of all the processes in a cgroup and its children.
+func readCgroupPids(dir string) []int32 {
+	var pids []int32
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || info.Name() != "cgroup.procs" {
+			return nil
+		}
+		lines, _ := util.ReadLines(path)
+		for _, line := range lines {
+			if pid, err := strconv.Atoi(strings.TrimSpace(line)); err == nil {
+				pids = append(pids, int32(pid))
+			}
+		}
+		return nil
+	})
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids
+}
+
+// fillCgroupStats sets the CPU, memory and IO usage and limits of ctr from the
+// cgroup v2 files in dir. CPU times are converted to ticks to match the values
+// reported for docker containers.
+func fillCgroupStats(ctr *docker.Container, dir string) {
+	cpu := readKeyValues(filepath.Join(dir, "cpu.stat"))
+	ctr.CPU = &docker.CgroupTimesStat{
+		ContainerID: ctr.ID,
+		User:        cpu["user_usec"] / 10000,
+		System:      cpu["system_usec"] / 10000,
+	}
+	ctr.CPUNrThrottled = cpu["nr_throttled"]
+
+	mem := readKeyValues(filepath.Join(dir, "memory.stat"))
+	ctr.Memory = &docker.CgroupMemStat{
+		ContainerID: ctr.ID,
+		RSS:         mem["anon"],
+		Cache:       mem["file"],
+		Swap:        readUint(filepath.Join(dir, "memory.swap.current")),
+	}
+	ctr.MemLimit = readUint(filepath.Join(dir, "memory.max"))
+
+	ctr.IO = &docker.CgroupIOStat{ContainerID: ctr.ID}
+	if lines, err := util.ReadLines(filepath.Join(dir, "io.stat")); err == nil {
+		for _, line := range lines {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			for _, f := range fields[1:] {
+				kv := strings.SplitN(f, "=", 2)
+				if len(kv) != 2 {
+					continue
+				}
+				v, _ := strconv.ParseUint(kv[1], 10, 64)
+				switch kv[0] {
+				case "rbytes":
+					ctr.IO.ReadBytes += v
+				case "wbytes":
+					ctr.IO.WriteBytes += v
+				}
+			}
+		}
+	}
+
+	// cpu.max is "<quota> <period>" with a quota of "max" when unlimited.
+	if lines, err := util.ReadLines(filepath.Join(dir, "cpu.max")); err == nil && len(lines) > 0 {
+		fields := strings.Fields(lines[0])
+		if len(fields) == 2 {
+			quota, qerr := strconv.ParseFloat(fields[0], 64)
+			period, perr := strconv.ParseFloat(fields[1], 64)
+			if qerr == nil && perr == nil && period > 0 {
+				ctr.CPULimit = quota / period * 100
+			}
+		}
+	}
+}
+
+// readNetStats returns the interface stats of the network namespace pid lives in,
+// which for rootless containers is the one slirp4netns or pasta is attached to.
+func readNetStats(pid int32) docker.ContainerNetStats {
+	stats := docker.ContainerNetStats{}
+	lines, err := util.ReadLines(util.HostProc(strconv.Itoa(int(pid)), "net", "dev"))
+	if err != nil {
+		return stats
+	}
+	// The first two lines are headers:
+	// Inter-|   Receive                            ...|  Transmit
+	//  face |bytes    packets errs drop fifo frame ...|bytes    packets ...
+	if len(lines) < 2 {
+		return stats
+	}
+	for _, line := range lines[2:] {
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		fields := strings.Fields(parts[1])
+		if name == "lo" || len(fields) < 10 {
+			continue
+		}
+		s := &docker.InterfaceNetStats{NetworkName: name}
+		s.BytesRcvd, _ = strconv.ParseUint(fields[0], 10, 64)
+		s.PacketsRcvd, _ = strconv.ParseUint(fields[1], 10, 64)
+		s.BytesSent, _ = strconv.ParseUint(fields[8], 10, 64)
+		s.PacketsSent, _ = strconv.ParseUint(fields[9], 10, 64)
+		stats = append(stats, s)
+	}
+	return stats
+}
+
+// readKeyValues parses flat keyed cgroup files such as cpu.stat and memory.stat.
+func readKeyValues(path string) map[string]uint64 {
+	values := make(map[string]uint64)
+	f, err := os.Open(path)
+	if err != nil {
+		return values
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values
+}
+
+// readUint reads a single value cgroup file, returning 0 for "max" or on errors.
+func readUint(path string) uint64 {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	v, _ := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return v
+}
@@ -0,0 +1,24 @@
+// +build docker,!linux
+
+package container
+
+import (
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/util/docker"
+)
+
+// podmanListener is only supported on Linux, where podman containers can be found
+// through their cgroups.
+type podmanListener struct{}
+
+func newPodmanListener() *podmanListener {
+	return &podmanListener{}
+}
+
+func (l *podmanListener) setCacheDuration(d time.Duration) {}
+
+// Containers returns all the running podman containers on the host.
+func (l *podmanListener) Containers() ([]*docker.Container, error) {
+	return nil, docker.ErrNotImplemented
+}
@@ -0,0 +1,189 @@
+// +build docker,linux
+
+package container
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	podmanAPIID     = "1111111111111111111111111111111111111111111111111111111111111111"
+	podmanStorageID = "2222222222222222222222222222222222222222222222222222222222222222"
+	podmanRootID    = "3333333333333333333333333333333333333333333333333333333333333333"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPodmanListener(t *testing.T) {
+	dir, err := ioutil.TempDir("", "podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := &podmanListener{
+		cgroupRoot: filepath.Join(dir, "cgroup"),
+		runRoot:    filepath.Join(dir, "run"),
+		passwdPath: filepath.Join(dir, "passwd"),
+		storageDir: filepath.Join(dir, "root", "storage"),
+		homeRoot:   dir,
+	}
+	writeTestFile(t, filepath.Join(l.cgroupRoot, "cgroup.controllers"), "cpu io memory pids\n")
+
+	// User 1000 runs the podman API service
+	apiScope := filepath.Join(l.cgroupRoot, "user.slice/user-1000.slice/user@1000.service/user.slice/libpod-"+podmanAPIID+".scope")
+	writeTestFile(t, filepath.Join(apiScope, "container", "cgroup.procs"), "42\n43\n")
+	writeTestFile(t, filepath.Join(apiScope, "cpu.stat"), "usage_usec 3000000\nuser_usec 2000000\nsystem_usec 1000000\nnr_throttled 4\n")
+	writeTestFile(t, filepath.Join(apiScope, "cpu.max"), "50000 100000\n")
+	writeTestFile(t, filepath.Join(apiScope, "memory.stat"), "anon 1024\nfile 2048\n")
+	writeTestFile(t, filepath.Join(apiScope, "memory.max"), "4096\n")
+	writeTestFile(t, filepath.Join(apiScope, "io.stat"), "8:0 rbytes=100 wbytes=200 rios=1 wios=2\n8:16 rbytes=10 wbytes=20\n")
+	// The conmon scope must not be mistaken for a container
+	writeTestFile(t, filepath.Join(l.cgroupRoot, "user.slice/user-1000.slice/user@1000.service/user.slice/libpod-conmon-"+podmanAPIID+".scope/cgroup.procs"), "41\n")
+
+	socket := filepath.Join(l.runRoot, "user", "1000", "podman", "podman.sock")
+	if err := os.MkdirAll(filepath.Dir(socket), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1.0.0/libpod/containers/json", r.URL.Path)
+		w.Write([]byte(`[{"Id":"` + podmanAPIID + `","Names":["web"],"Image":"docker.io/library/nginx:1.15","ImageID":"sha256:abc","Created":"2018-03-01T10:00:00Z","State":"running"}]`))
+	})}
+	go srv.Serve(ln)
+	defer srv.Close()
+
+	// User 1001 has no API service so we fall back on its containers storage
+	storageScope := filepath.Join(l.cgroupRoot, "user.slice/user-1001.slice/user@1001.service/user.slice/libpod-"+podmanStorageID+".scope")
+	writeTestFile(t, filepath.Join(storageScope, "cgroup.procs"), "51\n")
+	writeTestFile(t, l.passwdPath, "root:x:0:0:root:/root:/bin/bash\nci:x:1001:1001::/home/ci:/bin/sh\n")
+	writeTestFile(t, filepath.Join(dir, "home", "ci", ".local/share/containers/storage/overlay-containers/containers.json"),
+		`[{"id":"`+podmanStorageID+`","names":["builder"],"image":"sha256:def","metadata":"{\"image-name\":\"quay.io/ci/builder:latest\",\"name\":\"builder\"}","created":"2018-03-02T10:00:00Z"}]`)
+
+	// Rootful containers without any known state are still reported
+	writeTestFile(t, filepath.Join(l.cgroupRoot, "machine.slice/libpod-"+podmanRootID+".scope/cgroup.procs"), "61\n")
+	// Stopped containers keep their scope until it's cleaned up but have no processes
+	writeTestFile(t, filepath.Join(l.cgroupRoot, "machine.slice/libpod-4444444444444444444444444444444444444444444444444444444444444444.scope/cgroup.procs"), "")
+
+	ctrs, err := l.Containers()
+	assert.NoError(t, err)
+	if !assert.Len(t, ctrs, 3) {
+		return
+	}
+
+	api := ctrs[0]
+	assert.Equal(t, "podman", api.Type)
+	assert.Equal(t, podmanAPIID, api.ID)
+	assert.Equal(t, "podman://"+podmanAPIID, api.EntityID)
+	assert.Equal(t, "web", api.Name)
+	assert.Equal(t, "docker.io/library/nginx:1.15", api.Image)
+	assert.Equal(t, "sha256:abc", api.ImageID)
+	assert.Equal(t, int64(1519898400), api.Created)
+	assert.Equal(t, "running", api.State)
+	assert.Equal(t, []int32{42, 43}, api.Pids)
+	assert.Equal(t, uint64(200), api.CPU.User)
+	assert.Equal(t, uint64(100), api.CPU.System)
+	assert.Equal(t, uint64(4), api.CPUNrThrottled)
+	assert.Equal(t, float64(50), api.CPULimit)
+	assert.Equal(t, uint64(1024), api.Memory.RSS)
+	assert.Equal(t, uint64(2048), api.Memory.Cache)
+	assert.Equal(t, uint64(4096), api.MemLimit)
+	assert.Equal(t, uint64(110), api.IO.ReadBytes)
+	assert.Equal(t, uint64(220), api.IO.WriteBytes)
+
+	storage := ctrs[1]
+	assert.Equal(t, podmanStorageID, storage.ID)
+	assert.Equal(t, "builder", storage.Name)
+	assert.Equal(t, "quay.io/ci/builder:latest", storage.Image)
+	assert.Equal(t, "sha256:def", storage.ImageID)
+	assert.Equal(t, "running", storage.State)
+	assert.Equal(t, []int32{51}, storage.Pids)
+
+	root := ctrs[2]
+	assert.Equal(t, podmanRootID, root.ID)
+	assert.Equal(t, "", root.Name)
+	assert.Equal(t, []int32{61}, root.Pids)
+	assert.Equal(t, uint64(0), root.MemLimit)
+}
+
+func TestPodmanListenerUnavailable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := &podmanListener{cgroupRoot: dir, runRoot: dir}
+
+	// cgroup v1 hosts can't run rootless containers
+	_, err = l.Containers()
+	assert.True(t, strings.HasPrefix(err.Error(), "permanent failure"))
+
+	// cgroup v2 hosts without podman
+	writeTestFile(t, filepath.Join(dir, "cgroup.controllers"), "")
+	_, err = l.Containers()
+	assert.Error(t, err)
+
+	// podman is used but without any running containers
+	if err := os.MkdirAll(filepath.Join(dir, "user", "1000", "podman"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	ctrs, err := l.Containers()
+	assert.NoError(t, err)
+	assert.Len(t, ctrs, 0)
+}
+
+func TestPodmanListenerCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "podman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := &podmanListener{cgroupRoot: dir, runRoot: dir, cacheDuration: time.Minute, absentRetry: time.Hour}
+	writeTestFile(t, filepath.Join(dir, "cgroup.controllers"), "")
+
+	// podman not being in use is only checked again after absentRetry
+	_, err = l.Containers()
+	assert.Equal(t, errPodmanNotInUse, err)
+	if err := os.MkdirAll(filepath.Join(dir, "podman"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	_, err = l.Containers()
+	assert.Equal(t, errPodmanNotInUse, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), l.expiry, time.Minute)
+
+	// The containers found are reused for cacheDuration
+	l.expiry = time.Time{}
+	ctrs, err := l.Containers()
+	assert.NoError(t, err)
+	assert.Len(t, ctrs, 0)
+	writeTestFile(t, filepath.Join(dir, "machine.slice/libpod-"+podmanRootID+".scope/cgroup.procs"), "61\n")
+	ctrs, err = l.Containers()
+	assert.NoError(t, err)
+	assert.Len(t, ctrs, 0)
+
+	l.expiry = time.Time{}
+	ctrs, err = l.Containers()
+	assert.NoError(t, err)
+	assert.Len(t, ctrs, 1)
+}
@@ -67,6 +67,12 @@ func HostSys(combineWith ...string) string {
 	return GetEnv("HOST_SYS", "/sys", combineWith...)
 }
 
+// HostRoot returns the location of the host's root filesystem. This can and
+// will be overriden when running inside a container.
+func HostRoot(combineWith ...string) string {
+	return GetEnv("HOST_ROOT", "/", combineWith...)
+}
+
 // PathExists returns a boolean indicating if the given path exists on the file system.
 func PathExists(filename string) bool {
 	if _, err := os.Stat(filename); err == nil {